@@ -0,0 +1,13 @@
+# export every feature of the payments team from production
+data "unleash_feature_export" "payments" {
+  project     = "default"
+  environment = "production"
+  tag = {
+    type  = "simple"
+    value = "payments"
+  }
+}
+
+output "exported_features" {
+  value = data.unleash_feature_export.payments.features
+}
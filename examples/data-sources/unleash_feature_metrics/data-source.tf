@@ -0,0 +1,12 @@
+data "unleash_feature_metrics" "checkout" {
+  project = "default"
+  feature = "new-checkout"
+  hours   = 12
+}
+
+output "checkout_evaluations_in_production" {
+  value = one([
+    for environment in data.unleash_feature_metrics.checkout.environments :
+    environment.yes + environment.no if environment.name == "production"
+  ])
+}
@@ -0,0 +1,17 @@
+# Every stale release flag owned by the payments team, in any project
+data "unleash_features" "stale_payments" {
+  type  = "release"
+  state = "stale"
+
+  tag = {
+    type  = "simple"
+    value = "team-payments"
+  }
+}
+
+output "stale_payments_flags" {
+  value = {
+    for feature in data.unleash_features.stale_payments.features :
+    feature.name => [for environment in feature.environments : environment.name if environment.enabled]
+  }
+}
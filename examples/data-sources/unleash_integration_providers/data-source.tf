@@ -0,0 +1,5 @@
+data "unleash_integration_providers" "all" {}
+
+output "slack_app_events" {
+  value = one([for provider in data.unleash_integration_providers.all.providers : provider.events if provider.name == "slack-app"])
+}
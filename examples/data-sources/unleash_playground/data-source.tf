@@ -0,0 +1,18 @@
+data "unleash_playground" "tenant_x" {
+  environment = "production"
+  projects    = ["default"]
+  context = {
+    user_id = "42"
+    properties = {
+      tenant = "x"
+    }
+  }
+}
+
+# warns on every plan and apply once the beta flag reaches tenant x
+check "beta_off_for_tenant_x" {
+  assert {
+    condition     = !data.unleash_playground.tenant_x.features["beta"].enabled
+    error_message = "The beta flag is enabled for tenant x in production."
+  }
+}
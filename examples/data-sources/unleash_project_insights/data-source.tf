@@ -0,0 +1,14 @@
+data "unleash_project_insights" "payments" {
+  project = "payments"
+}
+
+check "payments_health" {
+  assert {
+    condition     = data.unleash_project_insights.payments.health >= 80
+    error_message = "Clean up ${join(", ", data.unleash_project_insights.payments.stale_flags)} to bring the health of the payments project back up."
+  }
+}
+
+output "payments_flag_types" {
+  value = data.unleash_project_insights.payments.flag_type_counts
+}
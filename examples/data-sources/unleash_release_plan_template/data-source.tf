@@ -0,0 +1,7 @@
+data "unleash_release_plan_template" "gradual_rollout" {
+  name = "gradual-rollout"
+}
+
+output "milestones" {
+  value = [for milestone in data.unleash_release_plan_template.gradual_rollout.milestones : milestone.name]
+}
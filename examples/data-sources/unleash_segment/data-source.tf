@@ -0,0 +1,3 @@
+data "unleash_segment" "beta_users" {
+  name = "beta-users"
+}
@@ -0,0 +1,9 @@
+data "unleash_strategies" "active" {}
+
+data "unleash_strategies" "all" {
+  include_deprecated = true
+}
+
+output "custom_strategy_names" {
+  value = [for strategy in data.unleash_strategies.active.strategies : strategy.name if strategy.editable]
+}
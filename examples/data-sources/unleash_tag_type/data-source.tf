@@ -0,0 +1,7 @@
+data "unleash_tag_type" "team" {
+  name = "team"
+}
+
+output "teams" {
+  value = data.unleash_tag_type.team.tags
+}
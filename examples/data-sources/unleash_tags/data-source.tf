@@ -0,0 +1,5 @@
+data "unleash_tags" "jira" {
+  type = "jira"
+}
+
+data "unleash_tags" "all" {}
@@ -0,0 +1,8 @@
+data "unleash_unused_features" "cleanup" {
+  project = "default"
+  days    = 30
+}
+
+output "flags_to_remove" {
+  value = data.unleash_unused_features.cleanup.features[*].name
+}
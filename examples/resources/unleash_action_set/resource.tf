@@ -0,0 +1,45 @@
+data "unleash_role" "admin" {
+  name = "Admin"
+}
+
+resource "unleash_service_account" "automation" {
+  name      = "automation"
+  username  = "automation"
+  root_role = data.unleash_role.admin.id
+}
+
+resource "unleash_signal_endpoint" "alerts" {
+  name        = "alerts"
+  token_names = ["alertmanager"]
+}
+
+resource "unleash_action_set" "checkout_kill_switch" {
+  project     = "default"
+  name        = "Turn off the new checkout"
+  description = "Turns the new checkout off when it pages"
+  actor_id    = unleash_service_account.automation.id
+
+  match = {
+    source_id = unleash_signal_endpoint.alerts.id
+    payload_filters = [{
+      parameter = "service"
+      operator  = "IN"
+      values    = ["checkout"]
+    }, {
+      parameter = "severity"
+      operator  = "IN"
+      values    = ["critical", "high"]
+    }]
+  }
+
+  actions = [{
+    action      = "TOGGLE_FEATURE_OFF"
+    feature     = "new-checkout"
+    environment = "production"
+  }]
+}
+
+import {
+  to = unleash_action_set.checkout_kill_switch
+  id = "default:1"
+}
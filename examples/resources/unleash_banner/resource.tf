@@ -0,0 +1,15 @@
+resource "unleash_banner" "maintenance" {
+  message         = "Unleash is read-only on Saturday between 08:00 and 10:00 UTC while we migrate the database."
+  variant         = "warning"
+  icon            = "construction"
+  link            = "dialog"
+  link_text       = "What does this mean?"
+  dialog_title    = "Planned maintenance"
+  dialog_markdown = "Changes made during the window **will fail**. Flag evaluation in SDKs is not affected."
+  sticky          = true
+}
+
+import {
+  to = unleash_banner.maintenance
+  id = "1"
+}
@@ -0,0 +1,13 @@
+# disable every payments feature in production during an incident, remove the resource to restore them
+resource "unleash_environment_kill_switch" "payments" {
+  project     = "default"
+  environment = "production"
+  tag = {
+    type  = "simple"
+    value = "payments"
+  }
+}
+
+output "disabled_during_incident" {
+  value = unleash_environment_kill_switch.payments.disabled_features
+}
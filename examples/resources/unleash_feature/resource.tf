@@ -0,0 +1,26 @@
+resource "unleash_feature" "new_checkout" {
+  project     = "default"
+  name        = "new-checkout"
+  description = "One page checkout"
+
+  lifecycle_completed = {
+    status  = "kept"
+    variant = "one-page"
+  }
+}
+
+# the old checkout lost to the new one, so clean it up
+resource "unleash_feature" "old_checkout" {
+  project  = "default"
+  name     = "old-checkout"
+  stale    = true
+  archived = true
+
+  lifecycle_completed = {
+    status = "discarded"
+  }
+}
+
+output "new_checkout_stage" {
+  value = unleash_feature.new_checkout.lifecycle_stage
+}
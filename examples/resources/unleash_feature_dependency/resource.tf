@@ -0,0 +1,37 @@
+import {
+  id = "default:new-checkout:checkout-api"
+  to = unleash_feature_dependency.checkout
+}
+
+# new-checkout is only enabled when checkout-api is enabled
+resource "unleash_feature_dependency" "checkout" {
+  project = "default"
+  child   = "new-checkout"
+  parent  = "checkout-api"
+}
+
+# dark-mode-banner is only enabled when dark-mode resolves to the "night" or "dusk" variant
+resource "unleash_feature_dependency" "dark_mode_banner" {
+  project  = "default"
+  child    = "dark-mode-banner"
+  parent   = "dark-mode"
+  variants = ["night", "dusk"]
+}
+
+# legacy-search is only enabled while new-search is disabled
+resource "unleash_feature_dependency" "legacy_search" {
+  project = "default"
+  child   = "legacy-search"
+  parent  = "new-search"
+  enabled = false
+}
+
+# In projects with change requests enabled, open a change request instead of writing directly
+resource "unleash_feature_dependency" "reviewed" {
+  project                = "default"
+  child                  = "new-pricing-page"
+  parent                 = "new-pricing"
+  change_request_mode    = "submit"
+  change_request_wait    = true
+  change_request_timeout = "1h"
+}
@@ -0,0 +1,12 @@
+# copy the features of a project into the same project on another instance
+data "unleash_feature_export" "checkout" {
+  project     = "checkout"
+  environment = "production"
+}
+
+resource "unleash_feature_import" "checkout" {
+  provider    = unleash.staging
+  project     = "checkout"
+  environment = "production"
+  document    = data.unleash_feature_export.checkout.document
+}
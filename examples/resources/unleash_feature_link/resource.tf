@@ -0,0 +1,21 @@
+import {
+  id = "default:new-checkout:01JTJNCJ5XVP2KPJFA03YRBZCA"
+  to = unleash_feature_link.ticket
+}
+
+resource "unleash_feature_link" "ticket" {
+  project = "default"
+  feature = "new-checkout"
+  url     = "https://jira.example.com/browse/CHK-123"
+  title   = "Jira ticket"
+}
+
+resource "unleash_feature_link" "dashboard" {
+  project = "default"
+  feature = "new-checkout"
+  url     = "https://grafana.example.com/d/checkout"
+}
+
+output "links_from_templates" {
+  value = unleash_feature_link.dashboard.template_links
+}
@@ -0,0 +1,46 @@
+# every feature of the checkout project, kept in flags.yaml next to the configuration:
+#
+# features:
+#   new-checkout:
+#     description: The redesigned checkout
+#     tags:
+#       - type: simple
+#         value: payments
+#     environments:
+#       production:
+#         enabled: true
+#         strategies:
+#           - name: flexibleRollout
+#             parameters:
+#               rollout: 25
+#               stickiness: default
+#               groupId: new-checkout
+#   express-shipping:
+#     type: experiment
+resource "unleash_feature_manifest" "checkout" {
+  project  = "checkout"
+  document = file("${path.module}/flags.yaml")
+}
+
+# the same manifest written in HCL, for a project where every feature must be in the manifest
+resource "unleash_feature_manifest" "search" {
+  project           = "search"
+  archive_unmanaged = true
+  document = {
+    features = {
+      instant-search = {
+        environments = {
+          production = {
+            enabled    = true
+            strategies = [{ name = "default" }]
+          }
+        }
+      }
+    }
+  }
+}
+
+import {
+  to = unleash_feature_manifest.checkout
+  id = "checkout"
+}
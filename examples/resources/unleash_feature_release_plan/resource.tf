@@ -0,0 +1,26 @@
+import {
+  id = "default:new-checkout:production:01JB9GGTGQYEQ9D40R17T3YVW3"
+  to = unleash_feature_release_plan.checkout
+}
+
+data "unleash_release_plan_template" "gradual_rollout" {
+  name = "gradual-rollout"
+}
+
+resource "unleash_feature_release_plan" "checkout" {
+  project     = "default"
+  feature     = "new-checkout"
+  environment = "production"
+  template_id = data.unleash_release_plan_template.gradual_rollout.id
+
+  # promote the rollout by changing this in a pull request
+  active_milestone = "25%"
+
+  change_request_mode = "submit"
+}
+
+output "checkout_progress" {
+  value = {
+    for milestone in unleash_feature_release_plan.checkout.milestones : milestone.name => milestone.started_at
+  }
+}
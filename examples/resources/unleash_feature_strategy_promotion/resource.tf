@@ -0,0 +1,35 @@
+import {
+  id = "default:new-checkout:staging:production"
+  to = unleash_feature_strategy_promotion.checkout
+}
+
+resource "unleash_feature_strategy_promotion" "checkout" {
+  project            = "default"
+  feature            = "new-checkout"
+  source_environment = "staging"
+  target_environment = "production"
+  mode               = "replace"
+
+  change_request_mode = "submit"
+}
+
+# promotions can be chained, each waiting for the one before it
+resource "unleash_feature_strategy_promotion" "checkout_staging" {
+  project            = "default"
+  feature            = "new-checkout"
+  source_environment = "development"
+  target_environment = "staging"
+}
+
+resource "unleash_feature_strategy_promotion" "checkout_production" {
+  project            = "default"
+  feature            = "new-checkout"
+  source_environment = "staging"
+  target_environment = "production"
+
+  depends_on = [unleash_feature_strategy_promotion.checkout_staging]
+}
+
+output "checkout_promotion" {
+  value = unleash_feature_strategy_promotion.checkout.diff
+}
@@ -0,0 +1,26 @@
+import {
+  id = "new-checkout"
+  to = unleash_feature_tags.new_checkout
+}
+
+resource "unleash_tag_type" "team" {
+  name = "team"
+}
+
+resource "unleash_tag_type" "jira" {
+  name = "jira"
+}
+
+resource "unleash_feature_tags" "new_checkout" {
+  feature = "new-checkout"
+  tags = [
+    {
+      type  = unleash_tag_type.team.name
+      value = "checkout"
+    },
+    {
+      type  = unleash_tag_type.jira.name
+      value = "CHK-123"
+    }
+  ]
+}
@@ -0,0 +1,11 @@
+variable "app_domains" {
+  type    = list(string)
+  default = ["app.example.com", "checkout.example.com"]
+}
+
+resource "unleash_frontend_settings" "settings" {
+  cors_origins = concat(
+    [for domain in var.app_domains : "https://${domain}"],
+    ["http://localhost:3000"],
+  )
+}
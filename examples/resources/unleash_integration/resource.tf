@@ -0,0 +1,34 @@
+variable "audit_token" {
+  type      = string
+  sensitive = true
+}
+
+variable "datadog_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "unleash_integration" "audit" {
+  description = "Forward flag changes to the audit service"
+  events      = ["feature-created", "feature-updated", "feature-archived", "feature-environment-enabled", "feature-environment-disabled"]
+
+  webhook = {
+    url           = "https://audit.example.com/unleash"
+    authorization = "Bearer ${var.audit_token}"
+  }
+}
+
+resource "unleash_integration" "datadog" {
+  events       = ["feature-environment-enabled", "feature-environment-disabled"]
+  environments = ["production"]
+
+  datadog = {
+    url     = "https://api.datadoghq.eu/api/v1/events"
+    api_key = var.datadog_api_key
+  }
+}
+
+import {
+  to = unleash_integration.audit
+  id = "3"
+}
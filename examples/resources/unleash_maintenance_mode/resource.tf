@@ -0,0 +1,9 @@
+variable "migration_in_progress" {
+  type    = bool
+  default = false
+}
+
+# Turn on while migrating the database, so nobody changes flags in the meantime
+resource "unleash_maintenance_mode" "this" {
+  enabled = var.migration_in_progress
+}
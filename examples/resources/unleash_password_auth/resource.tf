@@ -0,0 +1,18 @@
+variable "oidc_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "unleash_oidc" "sso" {
+  enabled      = true
+  discover_url = "https://login.example.com/.well-known/openid-configuration"
+  client_id    = "unleash"
+  secret       = var.oidc_secret
+}
+
+resource "unleash_password_auth" "password" {
+  enabled = false
+
+  # lets Terraform disable password login in the same apply that enables OIDC
+  single_sign_on_enabled = unleash_oidc.sso.enabled
+}
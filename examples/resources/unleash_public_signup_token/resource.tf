@@ -0,0 +1,18 @@
+# Unleash only allows invite links to expire up to a month ahead
+resource "unleash_public_signup_token" "contractors" {
+  name       = "Contractors"
+  expires_at = "2026-11-15T00:00:00Z"
+}
+
+output "contractor_invite_link" {
+  value = unleash_public_signup_token.contractors.url
+}
+
+output "contractor_signups" {
+  value = unleash_public_signup_token.contractors.user_count
+}
+
+import {
+  to = unleash_public_signup_token.contractors
+  id = "e0bc4f2a9d6c7b18"
+}
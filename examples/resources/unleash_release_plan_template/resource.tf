@@ -0,0 +1,65 @@
+import {
+  id = "01JTJNCJ5XVP2KPJFA03YRBZCA"
+  to = unleash_release_plan_template.gradual_rollout
+}
+
+resource "unleash_segment" "internal" {
+  name = "internal-users"
+  constraints = [
+    {
+      context_name = "email"
+      operator     = "STR_ENDS_WITH"
+      values       = ["@example.com"]
+    }
+  ]
+}
+
+resource "unleash_release_plan_template" "gradual_rollout" {
+  name        = "gradual-rollout"
+  description = "Internal users first, then a quarter of everyone, then everyone"
+  milestones = [
+    {
+      name = "Internal"
+      strategies = [
+        {
+          name       = "flexibleRollout"
+          title      = "Employees"
+          parameters = { rollout = "100", stickiness = "default", groupId = "{{featureName}}" }
+          segments   = [unleash_segment.internal.id]
+        }
+      ]
+    },
+    {
+      name = "25%"
+      strategies = [
+        {
+          name       = "flexibleRollout"
+          parameters = { rollout = "25", stickiness = "userId", groupId = "{{featureName}}" }
+          variants = [
+            {
+              name       = "control"
+              stickiness = "userId"
+            },
+            {
+              name       = "treatment"
+              stickiness = "userId"
+              payload = {
+                type  = "json"
+                value = jsonencode({ layout = "compact" })
+              }
+            }
+          ]
+        }
+      ]
+    },
+    {
+      name = "Everyone"
+      strategies = [
+        {
+          name       = "flexibleRollout"
+          parameters = { rollout = "100", stickiness = "default", groupId = "{{featureName}}" }
+        }
+      ]
+    }
+  ]
+}
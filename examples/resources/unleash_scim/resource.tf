@@ -0,0 +1,12 @@
+resource "unleash_scim" "okta" {
+  enabled                          = true
+  assume_control_of_existing_users = true
+
+  # change to generate a new token, which revokes the previous one
+  rotate_token_trigger = "2024-06"
+}
+
+output "scim_token" {
+  value     = unleash_scim.okta.token
+  sensitive = true
+}
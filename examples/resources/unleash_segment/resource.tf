@@ -0,0 +1,30 @@
+resource "unleash_segment" "beta_users" {
+  name        = "beta-users"
+  description = "Users enrolled in the beta programme"
+  constraints = [
+    {
+      context_name = "userId"
+      operator     = "IN"
+      values       = ["1", "2", "3"]
+    },
+    {
+      context_name     = "appName"
+      operator         = "STR_STARTS_WITH"
+      values           = ["beta"]
+      case_insensitive = true
+    }
+  ]
+}
+
+resource "unleash_segment" "project_segment" {
+  name          = "checkout-testers"
+  project       = "default"
+  force_destroy = true
+  constraints = [
+    {
+      context_name = "currentTime"
+      operator     = "DATE_AFTER"
+      value        = "2024-01-01T00:00:00.000Z"
+    }
+  ]
+}
@@ -0,0 +1,16 @@
+resource "unleash_signal_endpoint" "alerts" {
+  name        = "alerts"
+  description = "Alerts from our monitoring, used as kill switches"
+  token_names = ["alertmanager"]
+}
+
+# the secret is only known when the token is created
+output "alertmanager_token" {
+  value     = unleash_signal_endpoint.alerts.tokens[0].token
+  sensitive = true
+}
+
+import {
+  to = unleash_signal_endpoint.alerts
+  id = "1"
+}
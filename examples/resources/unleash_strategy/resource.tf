@@ -0,0 +1,27 @@
+import {
+  id = "tenantRollout"
+  to = unleash_strategy.tenant_rollout
+}
+
+resource "unleash_strategy" "tenant_rollout" {
+  name        = "tenantRollout"
+  description = "Enables a feature for a list of tenants"
+  parameters = [
+    {
+      name        = "tenants"
+      type        = "list"
+      description = "Comma separated list of tenant ids"
+      required    = true
+    },
+    {
+      name        = "rollout"
+      type        = "percentage"
+      description = "Percentage of users within each tenant"
+    }
+  ]
+}
+
+resource "unleash_strategy" "legacy" {
+  name       = "legacyRegion"
+  deprecated = true
+}
@@ -0,0 +1,15 @@
+import {
+  id = "team"
+  to = unleash_tag_type.team
+}
+
+resource "unleash_tag_type" "team" {
+  name        = "team"
+  description = "The team that owns the feature"
+  icon        = "group"
+}
+
+resource "unleash_tag_type" "jira" {
+  name        = "jira"
+  description = "The Jira ticket tracking the feature"
+}
@@ -0,0 +1,519 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &actionSetResource{}
+	_ resource.ResourceWithConfigure   = &actionSetResource{}
+	_ resource.ResourceWithImportState = &actionSetResource{}
+	_ resource.ResourceWithModifyPlan  = &actionSetResource{}
+)
+
+// actionSetSourceIdPattern matches the ids of signal endpoints, which the API takes as numbers.
+var actionSetSourceIdPattern = regexp.MustCompile(`^[0-9]+$`)
+
+const (
+	actionToggleFeatureOn  = "TOGGLE_FEATURE_ON"
+	actionToggleFeatureOff = "TOGGLE_FEATURE_OFF"
+)
+
+func NewActionSetResource() resource.Resource {
+	return &actionSetResource{}
+}
+
+type actionSetResource struct {
+	client *unleash.APIClient
+}
+
+type actionSetResourceModel struct {
+	Id          types.String           `tfsdk:"id"`
+	Project     types.String           `tfsdk:"project"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	Enabled     types.Bool             `tfsdk:"enabled"`
+	ActorId     types.Int64            `tfsdk:"actor_id"`
+	Match       actionSetMatchModel    `tfsdk:"match"`
+	Actions     []actionSetActionModel `tfsdk:"actions"`
+}
+
+type actionSetMatchModel struct {
+	Source         types.String                  `tfsdk:"source"`
+	SourceId       types.String                  `tfsdk:"source_id"`
+	PayloadFilters []actionSetPayloadFilterModel `tfsdk:"payload_filters"`
+}
+
+type actionSetPayloadFilterModel struct {
+	Parameter       types.String   `tfsdk:"parameter"`
+	Operator        types.String   `tfsdk:"operator"`
+	Value           types.String   `tfsdk:"value"`
+	Values          []types.String `tfsdk:"values"`
+	Inverted        types.Bool     `tfsdk:"inverted"`
+	CaseInsensitive types.Bool     `tfsdk:"case_insensitive"`
+}
+
+type actionSetActionModel struct {
+	Action      types.String `tfsdk:"action"`
+	Feature     types.String `tfsdk:"feature"`
+	Environment types.String `tfsdk:"environment"`
+}
+
+type actionSetApiModel struct {
+	Id          int64                     `json:"id,omitempty"`
+	Name        string                    `json:"name"`
+	Description *string                   `json:"description"`
+	Enabled     bool                      `json:"enabled"`
+	ActorId     int64                     `json:"actorId"`
+	Match       actionSetMatchApiModel    `json:"match"`
+	Actions     []actionSetActionApiModel `json:"actions"`
+}
+
+type actionSetMatchApiModel struct {
+	Source   string                                    `json:"source"`
+	SourceId int64                                     `json:"sourceId"`
+	Payload  map[string]actionSetPayloadFilterApiModel `json:"payload"`
+}
+
+type actionSetPayloadFilterApiModel struct {
+	Operator        string   `json:"operator"`
+	Value           *string  `json:"value,omitempty"`
+	Values          []string `json:"values,omitempty"`
+	Inverted        bool     `json:"inverted"`
+	CaseInsensitive bool     `json:"caseInsensitive"`
+}
+
+type actionSetActionApiModel struct {
+	Action          string            `json:"action"`
+	SortOrder       int64             `json:"sortOrder"`
+	ExecutionParams map[string]string `json:"executionParams"`
+}
+
+type actionSetsApiModel struct {
+	Actions []actionSetApiModel `json:"actions"`
+}
+
+func (r *actionSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *actionSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action_set"
+}
+
+func (r *actionSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an action set, which toggles feature flags of a project when a matching signal arrives at a signal endpoint. Action sets are an enterprise feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the action set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "The project of the action set. Its actions toggle features of this project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the action set.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of what the action set is for.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the action set reacts to signals. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"actor_id": schema.Int64Attribute{
+				Description: "The id of the service account that performs the actions. It needs permission to toggle the features.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"match": schema.SingleNestedAttribute{
+				Description: "The signals that trigger the action set.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"source": schema.StringAttribute{
+						Description: "The kind of source the signal comes from. Only `signal-endpoint` is supported, which is the default.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("signal-endpoint"),
+						Validators: []validator.String{
+							stringvalidator.OneOf("signal-endpoint"),
+						},
+					},
+					"source_id": schema.StringAttribute{
+						Description: "The id of the signal endpoint the signal is sent to, like `unleash_signal_endpoint.alerts.id`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(actionSetSourceIdPattern, "must be the numeric id of a signal endpoint"),
+						},
+					},
+					"payload_filters": schema.ListNestedAttribute{
+						Description: "Conditions on the payload of the signal, which must all be met. Every signal from the source matches when not set.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"parameter": schema.StringAttribute{
+									Description: "The payload property to check.",
+									Required:    true,
+								},
+								"operator": schema.StringAttribute{
+									Description: "How to compare the property, using the operators of strategy constraints.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(constraintOperators...),
+									},
+								},
+								"value": schema.StringAttribute{
+									Description: "The value to compare with, for operators that take a single value.",
+									Optional:    true,
+								},
+								"values": schema.ListAttribute{
+									Description: "The values to compare with, for operators that take several values.",
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"inverted": schema.BoolAttribute{
+									Description: "Whether to negate the condition. Defaults to false.",
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(false),
+								},
+								"case_insensitive": schema.BoolAttribute{
+									Description: "Whether string comparisons ignore case. Defaults to false.",
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(false),
+								},
+							},
+						},
+					},
+				},
+			},
+			"actions": schema.ListNestedAttribute{
+				Description: "The actions to perform, in order.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Description: "What to do. One of `TOGGLE_FEATURE_ON` or `TOGGLE_FEATURE_OFF`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(actionToggleFeatureOn, actionToggleFeatureOff),
+							},
+						},
+						"feature": schema.StringAttribute{
+							Description: "The feature to toggle.",
+							Required:    true,
+						},
+						"environment": schema.StringAttribute{
+							Description: "The environment to toggle the feature in.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *actionSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *actionSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import action set resource")
+
+	// The unique identifier for an action set is: "<project>:<action_set_id>"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format '<project>:<action_set_id>'. Example: 'default:1'",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+
+	tflog.Debug(ctx, "Finished importing action set resource", map[string]any{"success": true})
+}
+
+func (r *actionSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create action set resource")
+	var plan actionSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.toApi(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var actionSet actionSetApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, actionSetsPath(plan.Project.ValueString()), request, &actionSet)
+	if !ValidateApiResponse(httpRes, 201, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(actionSet, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating action set resource", map[string]any{"success": true})
+}
+
+func (r *actionSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read action set resource")
+	var state actionSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var actionSets actionSetsApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, actionSetsPath(state.Project.ValueString()), nil, &actionSets)
+	if isNotFoundResponse(httpRes) {
+		tflog.Warn(ctx, fmt.Sprintf("Project %s not found, removing action set %s from state", state.Project.ValueString(), state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	for _, actionSet := range actionSets.Actions {
+		if strconv.FormatInt(actionSet.Id, 10) == state.Id.ValueString() {
+			state.hydrateFromApi(actionSet, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			tflog.Debug(ctx, "Finished reading action set resource", map[string]any{"success": true})
+			return
+		}
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("Action set with id %s not found, removing from state", state.Id.ValueString()))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *actionSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update action set resource")
+	var plan actionSetResourceModel
+	var state actionSetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = state.Id
+
+	request := plan.toApi(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var actionSet actionSetApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, actionSetPath(plan.Project.ValueString(), plan.Id.ValueString()), request, &actionSet)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(actionSet, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating action set resource", map[string]any{"success": true})
+}
+
+func (r *actionSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete action set resource")
+	var state actionSetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, actionSetPath(state.Project.ValueString(), state.Id.ValueString()), nil, nil)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting action set resource", map[string]any{"success": true})
+}
+
+func (m *actionSetResourceModel) toApi(diagnostics *diag.Diagnostics) actionSetApiModel {
+	sourceId, err := strconv.ParseInt(m.Match.SourceId.ValueString(), 10, 64)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("match").AtName("source_id"),
+			"Invalid source id",
+			fmt.Sprintf("%q is not the id of a signal endpoint.", m.Match.SourceId.ValueString()),
+		)
+	}
+
+	actionSet := actionSetApiModel{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+		Enabled:     m.Enabled.ValueBool(),
+		ActorId:     m.ActorId.ValueInt64(),
+		Match: actionSetMatchApiModel{
+			Source:   m.Match.Source.ValueString(),
+			SourceId: sourceId,
+			Payload:  map[string]actionSetPayloadFilterApiModel{},
+		},
+		Actions: make([]actionSetActionApiModel, 0, len(m.Actions)),
+	}
+
+	for _, filter := range m.Match.PayloadFilters {
+		actionSet.Match.Payload[filter.Parameter.ValueString()] = actionSetPayloadFilterApiModel{
+			Operator:        filter.Operator.ValueString(),
+			Value:           filter.Value.ValueStringPointer(),
+			Values:          stringSliceValues(filter.Values),
+			Inverted:        filter.Inverted.ValueBool(),
+			CaseInsensitive: filter.CaseInsensitive.ValueBool(),
+		}
+	}
+
+	for i, action := range m.Actions {
+		actionSet.Actions = append(actionSet.Actions, actionSetActionApiModel{
+			Action:    action.Action.ValueString(),
+			SortOrder: int64(i),
+			ExecutionParams: map[string]string{
+				"project":     m.Project.ValueString(),
+				"featureName": action.Feature.ValueString(),
+				"environment": action.Environment.ValueString(),
+			},
+		})
+	}
+
+	return actionSet
+}
+
+func (m *actionSetResourceModel) hydrateFromApi(actionSet actionSetApiModel, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(strconv.FormatInt(actionSet.Id, 10))
+	m.Name = types.StringValue(actionSet.Name)
+	m.Description = optionalStringValue(actionSet.Description)
+	m.Enabled = types.BoolValue(actionSet.Enabled)
+	m.ActorId = types.Int64Value(actionSet.ActorId)
+
+	// the payload is a map, so filters are kept in the configured order and new ones are sorted by parameter
+	prior := map[string]int{}
+	for i, filter := range m.Match.PayloadFilters {
+		prior[filter.Parameter.ValueString()] = i
+	}
+	parameters := make([]string, 0, len(actionSet.Match.Payload))
+	for parameter := range actionSet.Match.Payload {
+		parameters = append(parameters, parameter)
+	}
+	sort.SliceStable(parameters, func(i, j int) bool {
+		left, leftKnown := prior[parameters[i]]
+		right, rightKnown := prior[parameters[j]]
+		if leftKnown != rightKnown {
+			return leftKnown
+		}
+		if leftKnown {
+			return left < right
+		}
+		return parameters[i] < parameters[j]
+	})
+
+	var filters []actionSetPayloadFilterModel
+	for _, parameter := range parameters {
+		filter := actionSet.Match.Payload[parameter]
+		model := actionSetPayloadFilterModel{
+			Parameter:       types.StringValue(parameter),
+			Operator:        types.StringValue(filter.Operator),
+			Value:           optionalStringValue(filter.Value),
+			Inverted:        types.BoolValue(filter.Inverted),
+			CaseInsensitive: types.BoolValue(filter.CaseInsensitive),
+		}
+		if len(filter.Values) > 0 {
+			model.Values = stringValues(filter.Values)
+		}
+		filters = append(filters, model)
+	}
+	m.Match = actionSetMatchModel{
+		Source:         types.StringValue(actionSet.Match.Source),
+		SourceId:       types.StringValue(strconv.FormatInt(actionSet.Match.SourceId, 10)),
+		PayloadFilters: filters,
+	}
+
+	sorted := append([]actionSetActionApiModel(nil), actionSet.Actions...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SortOrder < sorted[j].SortOrder })
+
+	m.Actions = make([]actionSetActionModel, 0, len(sorted))
+	for _, action := range sorted {
+		if action.Action != actionToggleFeatureOn && action.Action != actionToggleFeatureOff {
+			diagnostics.AddError(
+				"Unsupported action",
+				fmt.Sprintf("Action set %d uses the %s action, which unleash_action_set doesn't support.", actionSet.Id, action.Action),
+			)
+			return
+		}
+		m.Actions = append(m.Actions, actionSetActionModel{
+			Action:      types.StringValue(action.Action),
+			Feature:     types.StringValue(action.ExecutionParams["featureName"]),
+			Environment: types.StringValue(action.ExecutionParams["environment"]),
+		})
+	}
+}
+
+func actionSetsPath(project string) string {
+	return "/api/admin/projects/" + url.PathEscape(project) + "/actions"
+}
+
+func actionSetPath(project string, id string) string {
+	return actionSetsPath(project) + "/" + url.PathEscape(id)
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testAccActionSetDependencies = `
+	data "unleash_role" "admin" {
+		name = "Admin"
+	}
+
+	resource "unleash_service_account" "automation" {
+		name      = "tf-action-set-automation"
+		username  = "tf-action-set-automation"
+		root_role = data.unleash_role.admin.id
+	}
+
+	resource "unleash_signal_endpoint" "alerts" {
+		name = "tf-action-set-alerts"
+	}
+`
+
+func TestAccActionSetResource(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-action-set-checkout")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionSetDependencies + `
+					resource "unleash_action_set" "kill_switch" {
+						project  = "default"
+						name     = "tf-kill-checkout"
+						actor_id = unleash_service_account.automation.id
+
+						match = {
+							source_id = unleash_signal_endpoint.alerts.id
+						}
+
+						actions = [{
+							action      = "TOGGLE_FEATURE_OFF"
+							feature     = "tf-action-set-checkout"
+							environment = "development"
+						}]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_action_set.kill_switch", "id"),
+					resource.TestCheckResourceAttr("unleash_action_set.kill_switch", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_action_set.kill_switch", "match.source", "signal-endpoint"),
+					resource.TestCheckNoResourceAttr("unleash_action_set.kill_switch", "match.payload_filters"),
+					resource.TestCheckResourceAttr("unleash_action_set.kill_switch", "actions.#", "1"),
+				),
+			},
+			{
+				Config: testAccActionSetDependencies + `
+					resource "unleash_action_set" "kill_switch" {
+						project     = "default"
+						name        = "tf-kill-checkout"
+						description = "Turns checkout off when it pages"
+						actor_id    = unleash_service_account.automation.id
+
+						match = {
+							source_id = unleash_signal_endpoint.alerts.id
+							payload_filters = [{
+								parameter = "severity"
+								operator  = "IN"
+								values    = ["critical", "high"]
+							}]
+						}
+
+						actions = [{
+							action      = "TOGGLE_FEATURE_OFF"
+							feature     = "tf-action-set-checkout"
+							environment = "development"
+						}, {
+							action      = "TOGGLE_FEATURE_OFF"
+							feature     = "tf-action-set-checkout"
+							environment = "production"
+						}]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_action_set.kill_switch", "description", "Turns checkout off when it pages"),
+					resource.TestCheckResourceAttr("unleash_action_set.kill_switch", "match.payload_filters.0.values.#", "2"),
+					resource.TestCheckResourceAttr("unleash_action_set.kill_switch", "match.payload_filters.0.inverted", "false"),
+					resource.TestCheckResourceAttr("unleash_action_set.kill_switch", "actions.1.environment", "production"),
+				),
+			},
+			{
+				ResourceName: "unleash_action_set.kill_switch",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["unleash_action_set.kill_switch"]
+					if !ok {
+						return "", fmt.Errorf("Resource not found: unleash_action_set.kill_switch")
+					}
+					return "default:" + rs.Primary.Attributes["id"], nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
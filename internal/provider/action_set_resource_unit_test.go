@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionSetToApi(t *testing.T) {
+	model := actionSetResourceModel{
+		Project: types.StringValue("checkout"),
+		Name:    types.StringValue("kill switch"),
+		Enabled: types.BoolValue(true),
+		ActorId: types.Int64Value(3),
+		Match: actionSetMatchModel{
+			Source:   types.StringValue("signal-endpoint"),
+			SourceId: types.StringValue("5"),
+			PayloadFilters: []actionSetPayloadFilterModel{{
+				Parameter:       types.StringValue("severity"),
+				Operator:        types.StringValue("IN"),
+				Values:          stringValues([]string{"critical", "high"}),
+				Inverted:        types.BoolValue(false),
+				CaseInsensitive: types.BoolValue(true),
+			}},
+		},
+		Actions: []actionSetActionModel{
+			{Action: types.StringValue(actionToggleFeatureOff), Feature: types.StringValue("new-checkout"), Environment: types.StringValue("production")},
+			{Action: types.StringValue(actionToggleFeatureOn), Feature: types.StringValue("old-checkout"), Environment: types.StringValue("production")},
+		},
+	}
+
+	var diagnostics diag.Diagnostics
+	actionSet := model.toApi(&diagnostics)
+	require.False(t, diagnostics.HasError(), diagnostics)
+	assert.Nil(t, actionSet.Description)
+	assert.Equal(t, int64(5), actionSet.Match.SourceId)
+	assert.Equal(t, actionSetPayloadFilterApiModel{Operator: "IN", Values: []string{"critical", "high"}, CaseInsensitive: true}, actionSet.Match.Payload["severity"])
+	require.Len(t, actionSet.Actions, 2)
+	assert.Equal(t, int64(1), actionSet.Actions[1].SortOrder)
+	assert.Equal(t, map[string]string{"project": "checkout", "featureName": "new-checkout", "environment": "production"}, actionSet.Actions[0].ExecutionParams)
+}
+
+func TestActionSetToApiInvalidSourceId(t *testing.T) {
+	model := actionSetResourceModel{
+		Match: actionSetMatchModel{
+			Source:   types.StringValue("signal-endpoint"),
+			SourceId: types.StringValue("alerts"),
+		},
+	}
+
+	var diagnostics diag.Diagnostics
+	model.toApi(&diagnostics)
+	require.True(t, diagnostics.HasError())
+	assert.Equal(t, "Invalid source id", diagnostics[0].Summary())
+}
+
+func TestActionSetHydrateFromApi(t *testing.T) {
+	model := actionSetResourceModel{
+		Match: actionSetMatchModel{PayloadFilters: []actionSetPayloadFilterModel{
+			{Parameter: types.StringValue("service")},
+			{Parameter: types.StringValue("severity")},
+		}},
+	}
+	value := "checkout"
+	var diagnostics diag.Diagnostics
+	model.hydrateFromApi(actionSetApiModel{
+		Id:      9,
+		Name:    "kill switch",
+		Enabled: true,
+		ActorId: 3,
+		Match: actionSetMatchApiModel{
+			Source:   "signal-endpoint",
+			SourceId: 5,
+			Payload: map[string]actionSetPayloadFilterApiModel{
+				"severity": {Operator: "IN", Values: []string{"critical"}},
+				"env":      {Operator: "STR_CONTAINS", Values: []string{"prod"}},
+				"service":  {Operator: "STR_CONTAINS", Value: &value},
+			},
+		},
+		Actions: []actionSetActionApiModel{
+			{Action: actionToggleFeatureOn, SortOrder: 1, ExecutionParams: map[string]string{"featureName": "old-checkout", "environment": "production"}},
+			{Action: actionToggleFeatureOff, SortOrder: 0, ExecutionParams: map[string]string{"featureName": "new-checkout", "environment": "production"}},
+		},
+	}, &diagnostics)
+	require.False(t, diagnostics.HasError(), diagnostics)
+
+	assert.Equal(t, "9", model.Id.ValueString())
+	assert.Equal(t, "5", model.Match.SourceId.ValueString())
+	assert.True(t, model.Description.IsNull())
+	parameters := []string{}
+	for _, filter := range model.Match.PayloadFilters {
+		parameters = append(parameters, filter.Parameter.ValueString())
+	}
+	assert.Equal(t, []string{"service", "severity", "env"}, parameters, "configured filters keep their order")
+	assert.Equal(t, "checkout", model.Match.PayloadFilters[0].Value.ValueString())
+	assert.Nil(t, model.Match.PayloadFilters[0].Values)
+	require.Len(t, model.Actions, 2)
+	assert.Equal(t, "new-checkout", model.Actions[0].Feature.ValueString(), "actions are sorted by their sort order")
+}
+
+func TestActionSetHydrateFromApiUnsupportedAction(t *testing.T) {
+	model := actionSetResourceModel{}
+	var diagnostics diag.Diagnostics
+	model.hydrateFromApi(actionSetApiModel{
+		Id:      9,
+		Actions: []actionSetActionApiModel{{Action: "SEND_WEBHOOK"}},
+	}, &diagnostics)
+	require.True(t, diagnostics.HasError())
+	assert.Equal(t, "Unsupported action", diagnostics[0].Summary())
+}
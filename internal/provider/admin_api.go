@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+)
+
+// adminApiRequest calls an Unleash admin endpoint that isn't covered by the generated client. It reuses the
+// client configuration, so requests carry the same headers and go through the same rate limited HTTP client.
+// When result is not nil, a successful JSON response body is decoded into it.
+func adminApiRequest(ctx context.Context, client *unleash.APIClient, method string, path string, body any, result any) (*http.Response, error) {
+	config := client.GetConfig()
+
+	baseUrl, err := config.ServerURLWithContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var requestBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		requestBody = bytes.NewReader(payload)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(baseUrl, "/")+path, requestBody)
+	if err != nil {
+		return nil, err
+	}
+
+	for header, value := range config.DefaultHeader {
+		request.Header.Set(header, value)
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", config.UserAgent)
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	responseBody, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	response.Body = io.NopCloser(bytes.NewReader(responseBody))
+	if err != nil {
+		return response, err
+	}
+
+	if response.StatusCode >= 300 {
+		return response, fmt.Errorf("%s %s", response.Status, strings.TrimSpace(string(responseBody)))
+	}
+
+	if result != nil && len(responseBody) > 0 {
+		if err := json.Unmarshal(responseBody, result); err != nil {
+			return response, fmt.Errorf("unable to decode response from %s %s: %w", method, path, err)
+		}
+	}
+
+	return response, nil
+}
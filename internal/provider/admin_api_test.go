@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAdminApiClient(t *testing.T, handler http.HandlerFunc) *unleash.APIClient {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	var diags diag.Diagnostics
+	client := unleashClient(context.Background(), &UnleashProvider{version: "test"}, &UnleashConfiguration{
+		BaseUrl:       types.StringValue(server.URL),
+		Authorization: types.StringValue("secret"),
+	}, &diags)
+	require.False(t, diags.HasError())
+
+	return client
+}
+
+func Test_adminApiRequest_sendsClientHeadersAndDecodesResponse(t *testing.T) {
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/admin/segments", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+		assert.Equal(t, terraformProviderAppName(), r.Header.Get(unleashAppNameHeader))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "beta-users", body["name"])
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 7, "name": "beta-users"}`))
+	})
+
+	var result struct {
+		Id   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+	response, err := adminApiRequest(context.Background(), client, http.MethodPost, "/api/admin/segments", map[string]string{"name": "beta-users"}, &result)
+
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, int64(7), result.Id)
+	assert.Equal(t, "beta-users", result.Name)
+}
+
+func Test_adminApiRequest_returnsErrorWithResponseBody(t *testing.T) {
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message": "segment is in use"}`))
+	})
+
+	response, err := adminApiRequest(context.Background(), client, http.MethodDelete, "/api/admin/segments/7", nil, nil)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "segment is in use")
+	if assert.NotNil(t, response) {
+		assert.Equal(t, http.StatusConflict, response.StatusCode)
+		assert.NotNil(t, response.Request)
+	}
+}
@@ -15,8 +15,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &apiTokenResource{}
-	_ resource.ResourceWithConfigure = &apiTokenResource{}
+	_ resource.Resource               = &apiTokenResource{}
+	_ resource.ResourceWithConfigure  = &apiTokenResource{}
+	_ resource.ResourceWithModifyPlan = &apiTokenResource{}
 )
 
 // NewApiTokenResource is a helper function to simplify the provider implementation.
@@ -107,6 +108,10 @@ func (r *apiTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+func (r *apiTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
 func (r *apiTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "Preparing to create api token resource")
 	var plan apiTokenResourceModel
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &bannerResource{}
+	_ resource.ResourceWithConfigure   = &bannerResource{}
+	_ resource.ResourceWithImportState = &bannerResource{}
+	_ resource.ResourceWithModifyPlan  = &bannerResource{}
+)
+
+func NewBannerResource() resource.Resource {
+	return &bannerResource{}
+}
+
+type bannerResource struct {
+	client *unleash.APIClient
+}
+
+type bannerResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Message        types.String `tfsdk:"message"`
+	Variant        types.String `tfsdk:"variant"`
+	Icon           types.String `tfsdk:"icon"`
+	Link           types.String `tfsdk:"link"`
+	LinkText       types.String `tfsdk:"link_text"`
+	DialogTitle    types.String `tfsdk:"dialog_title"`
+	DialogMarkdown types.String `tfsdk:"dialog_markdown"`
+	Sticky         types.Bool   `tfsdk:"sticky"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+}
+
+type bannerApiModel struct {
+	Id          int64   `json:"id,omitempty"`
+	Message     string  `json:"message"`
+	Variant     string  `json:"variant"`
+	Icon        *string `json:"icon"`
+	Link        *string `json:"link"`
+	LinkText    *string `json:"linkText"`
+	DialogTitle *string `json:"dialogTitle"`
+	Dialog      *string `json:"dialog"`
+	Sticky      bool    `json:"sticky"`
+	Enabled     bool    `json:"enabled"`
+}
+
+type bannersApiModel struct {
+	Banners []bannerApiModel `json:"banners"`
+}
+
+func (r *bannerResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *bannerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_banner"
+}
+
+func (r *bannerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a banner shown to every user of the Unleash UI, for example to announce a maintenance window. Banners are an enterprise feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the banner.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Description: "The message of the banner. Supports markdown.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"variant": schema.StringAttribute{
+				Description: "The style of the banner. One of `info`, `warning`, `error` or `success`. Defaults to `info`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("info"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("info", "warning", "error", "success"),
+				},
+			},
+			"icon": schema.StringAttribute{
+				Description: "The name of a [Material icon](https://fonts.google.com/icons) to show, or `none` to hide the icon. The icon of the variant is shown when not set.",
+				Optional:    true,
+			},
+			"link": schema.StringAttribute{
+				Description: "An absolute or relative link to show on the banner, or `dialog` to open the dialog.",
+				Optional:    true,
+			},
+			"link_text": schema.StringAttribute{
+				Description: "The text of the link. Unleash shows `More info` when not set.",
+				Optional:    true,
+			},
+			"dialog_title": schema.StringAttribute{
+				Description: "The title of the dialog. Defaults to the link text.",
+				Optional:    true,
+			},
+			"dialog_markdown": schema.StringAttribute{
+				Description: "The markdown content of the dialog opened by the link. No dialog is shown when not set.",
+				Optional:    true,
+			},
+			"sticky": schema.BoolAttribute{
+				Description: "Whether the banner stays at the top of the screen while scrolling. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the banner is shown. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *bannerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *bannerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import banner resource")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, "Finished importing banner resource", map[string]any{"success": true})
+}
+
+func (r *bannerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create banner resource")
+	var plan bannerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var banner bannerApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/banners", plan.toApi(), &banner)
+	if !ValidateApiResponse(httpRes, 201, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(banner)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating banner resource", map[string]any{"success": true})
+}
+
+func (r *bannerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read banner resource")
+	var state bannerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	banner, ok := r.findBanner(ctx, state.Id.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !ok {
+		tflog.Warn(ctx, fmt.Sprintf("Banner with id %s not found, removing from state", state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.hydrateFromApi(banner)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading banner resource", map[string]any{"success": true})
+}
+
+func (r *bannerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update banner resource")
+	var plan bannerResourceModel
+	var state bannerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = state.Id
+
+	var banner bannerApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, bannerPath(plan.Id.ValueString()), plan.toApi(), &banner)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(banner)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating banner resource", map[string]any{"success": true})
+}
+
+func (r *bannerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete banner resource")
+	var state bannerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, bannerPath(state.Id.ValueString()), nil, nil)
+	if !isNotFoundResponse(httpRes) && !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting banner resource", map[string]any{"success": true})
+}
+
+// findBanner looks a banner up in the list of banners, since Unleash has no endpoint to fetch a single one.
+func (r *bannerResource) findBanner(ctx context.Context, id string, diagnostics *diag.Diagnostics) (bannerApiModel, bool) {
+	var banners bannersApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, "/api/admin/banners", nil, &banners)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return bannerApiModel{}, false
+	}
+
+	for _, banner := range banners.Banners {
+		if strconv.FormatInt(banner.Id, 10) == id {
+			return banner, true
+		}
+	}
+	return bannerApiModel{}, false
+}
+
+func (m *bannerResourceModel) toApi() bannerApiModel {
+	return bannerApiModel{
+		Message:     m.Message.ValueString(),
+		Variant:     m.Variant.ValueString(),
+		Icon:        m.Icon.ValueStringPointer(),
+		Link:        m.Link.ValueStringPointer(),
+		LinkText:    m.LinkText.ValueStringPointer(),
+		DialogTitle: m.DialogTitle.ValueStringPointer(),
+		Dialog:      m.DialogMarkdown.ValueStringPointer(),
+		Sticky:      m.Sticky.ValueBool(),
+		Enabled:     m.Enabled.ValueBool(),
+	}
+}
+
+func (m *bannerResourceModel) hydrateFromApi(banner bannerApiModel) {
+	m.Id = types.StringValue(strconv.FormatInt(banner.Id, 10))
+	m.Message = types.StringValue(banner.Message)
+	m.Variant = types.StringValue(banner.Variant)
+	m.Icon = optionalStringValue(banner.Icon)
+	m.Link = optionalStringValue(banner.Link)
+	m.LinkText = optionalStringValue(banner.LinkText)
+	m.DialogTitle = optionalStringValue(banner.DialogTitle)
+	m.DialogMarkdown = optionalStringValue(banner.Dialog)
+	m.Sticky = types.BoolValue(banner.Sticky)
+	m.Enabled = types.BoolValue(banner.Enabled)
+}
+
+func bannerPath(id string) string {
+	return "/api/admin/banners/" + url.PathEscape(id)
+}
+
+// optionalStringValue treats empty strings, which Unleash stores for cleared fields, as unset.
+func optionalStringValue(value *string) types.String {
+	if value == nil || *value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*value)
+}
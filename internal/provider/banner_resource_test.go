@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBannerResource(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_banner" "maintenance" {
+						message = "Unleash is read-only on Saturday between 08:00 and 10:00 UTC."
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_banner.maintenance", "id"),
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "variant", "info"),
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "sticky", "false"),
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "enabled", "true"),
+					resource.TestCheckNoResourceAttr("unleash_banner.maintenance", "link"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_banner" "maintenance" {
+						message         = "Unleash is read-only on Saturday between 08:00 and 10:00 UTC."
+						variant         = "warning"
+						icon            = "construction"
+						link            = "dialog"
+						link_text       = "What does this mean?"
+						dialog_title    = "Planned maintenance"
+						dialog_markdown = "Changes made during the window **will fail**."
+						sticky          = true
+						enabled         = false
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "variant", "warning"),
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "icon", "construction"),
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "link", "dialog"),
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "dialog_title", "Planned maintenance"),
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "sticky", "true"),
+					resource.TestCheckResourceAttr("unleash_banner.maintenance", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "unleash_banner.maintenance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
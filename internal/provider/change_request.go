@@ -0,0 +1,359 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	changeRequestModeDirect = "direct"
+	changeRequestModeSubmit = "submit"
+
+	changeRequestStateDraft    = "Draft"
+	changeRequestStateInReview = "In review"
+	changeRequestStateApproved = "Approved"
+	changeRequestStateApplied  = "Applied"
+	changeRequestStateRejected = "Rejected"
+	changeRequestStateCanceled = "Cancelled"
+
+	defaultChangeRequestTimeout = "20m"
+)
+
+// changeRequestPollInterval is how often a submitted change request is checked while waiting for it to be applied.
+var changeRequestPollInterval = 10 * time.Second
+
+type changeRequestApiModel struct {
+	Id          int64                                `json:"id"`
+	Environment string                               `json:"environment"`
+	State       string                               `json:"state"`
+	Features    []changeRequestFeatureApiModel       `json:"features,omitempty"`
+	Segments    []changeRequestSegmentChangeApiModel `json:"segments,omitempty"`
+}
+
+type changeRequestFeatureApiModel struct {
+	Name    string                        `json:"name"`
+	Changes []changeRequestChangeApiModel `json:"changes"`
+}
+
+type changeRequestSegmentChangeApiModel struct {
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+type changeRequestChangeApiModel struct {
+	Feature string `json:"feature,omitempty"`
+	Action  string `json:"action"`
+	Payload any    `json:"payload,omitempty"`
+}
+
+type changeRequestStateApiModel struct {
+	State   string `json:"state"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// changeRequestSettings is what a resource configured through its change_request_* attributes.
+type changeRequestSettings struct {
+	Mode    string
+	Wait    bool
+	Timeout time.Duration
+}
+
+// changeRequestResourceAttributes returns the attributes shared by resources that can send their writes through
+// change requests. Resources add them to their own schema and model.
+func changeRequestResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"change_request_mode": schema.StringAttribute{
+			Description: "How writes to environments protected by change requests are made. `direct` writes straight to the API, which either fails or bypasses review depending on the token. `submit` opens a change request with the write instead, and fails when the token's user already has a draft change request with other changes in that environment. Defaults to `direct`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(changeRequestModeDirect),
+			Validators: []validator.String{
+				stringvalidator.OneOf(changeRequestModeDirect, changeRequestModeSubmit),
+			},
+		},
+		"change_request_wait": schema.BoolAttribute{
+			Description: "Whether to wait for a submitted change request to be approved and applied before finishing. Approved change requests are applied by the provider. Defaults to false.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"change_request_timeout": schema.StringAttribute{
+			Description: "How long to wait for a submitted change request, as a duration like `30m`. Only used when change_request_wait is true. Defaults to `20m`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(defaultChangeRequestTimeout),
+		},
+		"change_request_id": schema.StringAttribute{
+			Description: "The id of the change request opened by the last write, if any.",
+			Computed:    true,
+		},
+		"change_request_status": schema.StringAttribute{
+			Description: "The state of the change request opened by the last write, if any.",
+			Computed:    true,
+		},
+	}
+}
+
+func newChangeRequestSettings(mode types.String, wait types.Bool, timeout types.String, diagnostics *diag.Diagnostics) changeRequestSettings {
+	settings := changeRequestSettings{
+		Mode: changeRequestModeDirect,
+		Wait: wait.ValueBool(),
+	}
+	if !mode.IsNull() && !mode.IsUnknown() {
+		settings.Mode = mode.ValueString()
+	}
+
+	rawTimeout := defaultChangeRequestTimeout
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		rawTimeout = timeout.ValueString()
+	}
+
+	duration, err := time.ParseDuration(rawTimeout)
+	if err != nil || duration <= 0 {
+		diagnostics.AddAttributeError(
+			path.Root("change_request_timeout"),
+			"Invalid change request timeout",
+			fmt.Sprintf("%q is not a valid duration. Use a value like 30s, 10m or 1h.", rawTimeout),
+		)
+		return settings
+	}
+	settings.Timeout = duration
+
+	return settings
+}
+
+// changeRequestEnvironments returns the environments of a project that have change requests enabled.
+func changeRequestEnvironments(ctx context.Context, client *unleash.APIClient, project string, diagnostics *diag.Diagnostics) ([]string, bool) {
+	config, httpRes, err := client.ChangeRequestsAPI.GetProjectChangeRequestConfig(ctx, project).Execute()
+	if isNotFoundResponse(httpRes) {
+		// change requests are an enterprise feature, open source servers don't have the endpoint
+		return []string{}, true
+	}
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return nil, false
+	}
+
+	environments := []string{}
+	for _, environment := range config {
+		if environment.ChangeRequestEnabled {
+			environments = append(environments, environment.Environment)
+		}
+	}
+	return environments, true
+}
+
+// changeRequestEnvironment returns the environment a write should be submitted to as a change request, or an empty
+// string when it should be written directly. Writes that aren't tied to an environment pass an empty environment and
+// go to the first environment with change requests enabled.
+func changeRequestEnvironment(ctx context.Context, client *unleash.APIClient, project string, environment string, settings changeRequestSettings, diagnostics *diag.Diagnostics) (string, bool) {
+	if settings.Mode != changeRequestModeSubmit {
+		return "", true
+	}
+
+	environments, ok := changeRequestEnvironments(ctx, client, project, diagnostics)
+	if !ok {
+		return "", false
+	}
+
+	for _, protected := range environments {
+		if environment == "" || environment == protected {
+			return protected, true
+		}
+	}
+	return "", true
+}
+
+// defaultChangeRequestAttributes fills in the change request settings of a resource that was imported.
+func defaultChangeRequestAttributes(mode *types.String, wait *types.Bool, timeout *types.String) {
+	if mode.IsNull() {
+		*mode = types.StringValue(changeRequestModeDirect)
+	}
+	if wait.IsNull() {
+		*wait = types.BoolValue(false)
+	}
+	if timeout.IsNull() {
+		*timeout = types.StringValue(defaultChangeRequestTimeout)
+	}
+}
+
+// warnAboutDirectWrites adds a plan warning when a write in direct mode targets a project with change requests enabled.
+// Writes to a single environment pass it to only warn when that environment is protected.
+func warnAboutDirectWrites(ctx context.Context, client *unleash.APIClient, project string, environment string, mode types.String, subject string, diagnostics *diag.Diagnostics) {
+	if mode.IsUnknown() || (!mode.IsNull() && mode.ValueString() != changeRequestModeDirect) {
+		return
+	}
+
+	environments, ok := changeRequestEnvironments(ctx, client, project, diagnostics)
+	if !ok || len(environments) == 0 {
+		return
+	}
+
+	if environment != "" {
+		if !slices.Contains(environments, environment) {
+			return
+		}
+		environments = []string{environment}
+	}
+
+	diagnostics.AddAttributeWarning(
+		path.Root("change_request_mode"),
+		"Change requests are enabled",
+		fmt.Sprintf("Project %s requires change requests in %s. Writing %s directly will either fail or bypass review. Set change_request_mode = \"submit\" to open a change request instead.", project, strings.Join(environments, ", "), subject),
+	)
+}
+
+// submitChangeRequest opens a change request with the given changes and sends it to review. When the settings ask
+// for it, it then waits for the change request to be approved and applies it.
+func submitChangeRequest(ctx context.Context, client *unleash.APIClient, project string, environment string, changes []changeRequestChangeApiModel, settings changeRequestSettings, diagnostics *diag.Diagnostics) *changeRequestApiModel {
+	var changeRequest changeRequestApiModel
+	submitPath := "/api/admin/projects/" + url.PathEscape(project) + "/environments/" + url.PathEscape(environment) + "/change-requests"
+	httpRes, err := adminApiRequest(ctx, client, http.MethodPost, submitPath, changes, &changeRequest)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Opened change request %d in environment %s of project %s", changeRequest.Id, environment, project))
+
+	if changeRequest.State == changeRequestStateDraft {
+		// Unleash adds the changes to the draft the token's user already has in the environment, if any. Sending that
+		// draft to review would submit whatever else is in it too, so it's only sent when it holds just our changes.
+		if !hasOnlyChanges(changeRequest, changes) {
+			diagnostics.AddError(
+				"Change request has other changes",
+				fmt.Sprintf("Change request %d in environment %s of project %s was an existing draft with changes Terraform didn't make. "+
+					"Terraform's changes were added to it, but it was left as a draft. Submit or discard the draft in Unleash, then apply again.", changeRequest.Id, environment, project),
+			)
+			return nil
+		}
+		if !updateChangeRequestState(ctx, client, project, &changeRequest, changeRequestStateInReview, diagnostics) {
+			return nil
+		}
+	}
+
+	if !settings.Wait {
+		return &changeRequest
+	}
+
+	return waitForChangeRequest(ctx, client, project, changeRequest, settings.Timeout, diagnostics)
+}
+
+// waitForChangeRequest polls a change request until it's applied, rejected or cancelled. Approved change requests are
+// applied along the way. Running out of time is reported as a warning, the change request stays open in Unleash.
+func waitForChangeRequest(ctx context.Context, client *unleash.APIClient, project string, changeRequest changeRequestApiModel, timeout time.Duration, diagnostics *diag.Diagnostics) *changeRequestApiModel {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		switch changeRequest.State {
+		case changeRequestStateApplied:
+			return &changeRequest
+		case changeRequestStateRejected, changeRequestStateCanceled:
+			diagnostics.AddError(
+				"Change request not applied",
+				fmt.Sprintf("Change request %d in project %s was %s.", changeRequest.Id, project, changeRequest.State),
+			)
+			return &changeRequest
+		case changeRequestStateApproved:
+			if !updateChangeRequestState(ctx, client, project, &changeRequest, changeRequestStateApplied, diagnostics) {
+				return &changeRequest
+			}
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			diagnostics.AddWarning(
+				"Change request still pending",
+				fmt.Sprintf("Change request %d in project %s is still %s after %s. The change will be made once the change request is applied in Unleash.", changeRequest.Id, project, changeRequest.State, timeout),
+			)
+			return &changeRequest
+		}
+
+		select {
+		case <-ctx.Done():
+			diagnostics.AddWarning(
+				"Stopped waiting for change request",
+				fmt.Sprintf("Change request %d in project %s is still %s.", changeRequest.Id, project, changeRequest.State),
+			)
+			return &changeRequest
+		case <-time.After(changeRequestPollInterval):
+		}
+
+		httpRes, err := fetchChangeRequest(ctx, client, project, strconv.FormatInt(changeRequest.Id, 10), &changeRequest)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return &changeRequest
+		}
+	}
+}
+
+// hasOnlyChanges reports whether a change request holds exactly the given changes, comparing them by feature and
+// action.
+func hasOnlyChanges(changeRequest changeRequestApiModel, changes []changeRequestChangeApiModel) bool {
+	if len(changeRequest.Segments) > 0 {
+		return false
+	}
+
+	expected := map[string]int{}
+	for _, change := range changes {
+		expected[change.Feature+"/"+change.Action]++
+	}
+	for _, feature := range changeRequest.Features {
+		for _, change := range feature.Changes {
+			key := feature.Name + "/" + change.Action
+			if expected[key] == 0 {
+				return false
+			}
+			expected[key]--
+		}
+	}
+	for _, remaining := range expected {
+		if remaining != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func updateChangeRequestState(ctx context.Context, client *unleash.APIClient, project string, changeRequest *changeRequestApiModel, state string, diagnostics *diag.Diagnostics) bool {
+	statePath := changeRequestPath(project, strconv.FormatInt(changeRequest.Id, 10)) + "/state"
+	request := changeRequestStateApiModel{State: state}
+	if state == changeRequestStateInReview {
+		request.Comment = "Submitted by Terraform"
+	}
+
+	httpRes, err := adminApiRequest(ctx, client, http.MethodPut, statePath, request, changeRequest)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+func fetchChangeRequest(ctx context.Context, client *unleash.APIClient, project string, id string, changeRequest *changeRequestApiModel) (*http.Response, error) {
+	return adminApiRequest(ctx, client, http.MethodGet, changeRequestPath(project, id), nil, changeRequest)
+}
+
+func changeRequestPath(project string, id string) string {
+	return "/api/admin/projects/" + url.PathEscape(project) + "/change-requests/" + url.PathEscape(id)
+}
+
+// isChangeRequestPending reports whether a change request can still be applied.
+func isChangeRequestPending(state string) bool {
+	switch state {
+	case changeRequestStateApplied, changeRequestStateRejected, changeRequestStateCanceled, "":
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChangeRequestSettings(t *testing.T) {
+	var diags diag.Diagnostics
+
+	settings := newChangeRequestSettings(types.StringValue(changeRequestModeSubmit), types.BoolValue(true), types.StringValue("90s"), &diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, changeRequestModeSubmit, settings.Mode)
+	assert.True(t, settings.Wait)
+	assert.Equal(t, 90*time.Second, settings.Timeout)
+
+	settings = newChangeRequestSettings(types.StringNull(), types.BoolNull(), types.StringNull(), &diags)
+	require.False(t, diags.HasError())
+	assert.Equal(t, changeRequestModeDirect, settings.Mode)
+	assert.False(t, settings.Wait)
+	assert.Equal(t, 20*time.Minute, settings.Timeout)
+
+	newChangeRequestSettings(types.StringNull(), types.BoolNull(), types.StringValue("soon"), &diags)
+	assert.True(t, diags.HasError())
+}
+
+func TestChangeRequestEnvironment(t *testing.T) {
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/admin/projects/default/change-requests/config", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"environment": "development", "type": "development", "changeRequestEnabled": false, "requiredApprovals": null},
+			{"environment": "production", "type": "production", "changeRequestEnabled": true, "requiredApprovals": 1}
+		]`))
+	})
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	submit := changeRequestSettings{Mode: changeRequestModeSubmit}
+
+	environment, ok := changeRequestEnvironment(ctx, client, "default", "", submit, &diags)
+	assert.True(t, ok)
+	assert.Equal(t, "production", environment)
+
+	environment, ok = changeRequestEnvironment(ctx, client, "default", "development", submit, &diags)
+	assert.True(t, ok)
+	assert.Equal(t, "", environment)
+
+	environment, ok = changeRequestEnvironment(ctx, client, "default", "production", changeRequestSettings{Mode: changeRequestModeDirect}, &diags)
+	assert.True(t, ok)
+	assert.Equal(t, "", environment)
+	assert.False(t, diags.HasError())
+}
+
+func TestWarnAboutDirectWritesOnlyForProtectedEnvironments(t *testing.T) {
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"environment": "development", "type": "development", "changeRequestEnabled": false, "requiredApprovals": null},
+			{"environment": "production", "type": "production", "changeRequestEnabled": true, "requiredApprovals": 1}
+		]`))
+	})
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	warnAboutDirectWrites(ctx, client, "default", "development", types.StringValue(changeRequestModeDirect), "this write", &diags)
+	assert.Empty(t, diags)
+
+	warnAboutDirectWrites(ctx, client, "default", "production", types.StringValue(changeRequestModeSubmit), "this write", &diags)
+	assert.Empty(t, diags)
+
+	warnAboutDirectWrites(ctx, client, "default", "", types.StringValue(changeRequestModeDirect), "this write", &diags)
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail(), "production")
+}
+
+func TestSubmitChangeRequestSendsItToReview(t *testing.T) {
+	var requests []string
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST /api/admin/projects/default/environments/production/change-requests":
+			var changes []changeRequestChangeApiModel
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&changes))
+			assert.Equal(t, "addDependency", changes[0].Action)
+			_, _ = w.Write([]byte(`{"id": 12, "environment": "production", "state": "Draft", "features": [{"name": "child", "changes": [{"id": 1, "action": "addDependency", "payload": {"feature": "parent"}}]}], "segments": []}`))
+		case "PUT /api/admin/projects/default/change-requests/12/state":
+			var state changeRequestStateApiModel
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&state))
+			assert.Equal(t, changeRequestStateInReview, state.State)
+			_, _ = w.Write([]byte(`{"id": 12, "environment": "production", "state": "In review"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	var diags diag.Diagnostics
+	changes := []changeRequestChangeApiModel{{Feature: "child", Action: "addDependency", Payload: map[string]string{"feature": "parent"}}}
+	changeRequest := submitChangeRequest(context.Background(), client, "default", "production", changes, changeRequestSettings{Mode: changeRequestModeSubmit}, &diags)
+
+	require.False(t, diags.HasError())
+	require.NotNil(t, changeRequest)
+	assert.Equal(t, int64(12), changeRequest.Id)
+	assert.Equal(t, changeRequestStateInReview, changeRequest.State)
+	assert.Len(t, requests, 2)
+}
+
+func TestSubmitChangeRequestLeavesDraftsWithOtherChanges(t *testing.T) {
+	var requests []string
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 12, "environment": "production", "state": "Draft", "features": [
+			{"name": "child", "changes": [{"id": 1, "action": "addDependency", "payload": {"feature": "parent"}}]},
+			{"name": "other", "changes": [{"id": 2, "action": "updateEnabled", "payload": {"enabled": true}}]}
+		], "segments": []}`))
+	})
+
+	var diags diag.Diagnostics
+	changes := []changeRequestChangeApiModel{{Feature: "child", Action: "addDependency", Payload: map[string]string{"feature": "parent"}}}
+	changeRequest := submitChangeRequest(context.Background(), client, "default", "production", changes, changeRequestSettings{Mode: changeRequestModeSubmit}, &diags)
+
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Change request has other changes", diags[0].Summary())
+	assert.Nil(t, changeRequest)
+	assert.Equal(t, []string{"POST /api/admin/projects/default/environments/production/change-requests"}, requests)
+}
+
+func TestHasOnlyChanges(t *testing.T) {
+	changes := []changeRequestChangeApiModel{
+		{Feature: "one", Action: "addStrategy"},
+		{Feature: "one", Action: "addStrategy"},
+		{Feature: "two", Action: "updateStrategy"},
+	}
+	changeRequest := changeRequestApiModel{Features: []changeRequestFeatureApiModel{
+		{Name: "one", Changes: []changeRequestChangeApiModel{{Action: "addStrategy"}, {Action: "addStrategy"}}},
+		{Name: "two", Changes: []changeRequestChangeApiModel{{Action: "updateStrategy"}}},
+	}}
+	assert.True(t, hasOnlyChanges(changeRequest, changes))
+
+	assert.False(t, hasOnlyChanges(changeRequest, changes[1:]), "a change from before")
+	assert.False(t, hasOnlyChanges(changeRequestApiModel{Features: changeRequest.Features[:1]}, changes), "a missing change")
+
+	changeRequest.Segments = []changeRequestSegmentChangeApiModel{{Name: "beta", Action: "updateSegment"}}
+	assert.False(t, hasOnlyChanges(changeRequest, changes), "a segment change")
+}
+
+func TestWaitForChangeRequestAppliesApprovedChangeRequests(t *testing.T) {
+	previousInterval := changeRequestPollInterval
+	changeRequestPollInterval = time.Millisecond
+	t.Cleanup(func() { changeRequestPollInterval = previousInterval })
+
+	applied := false
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodGet:
+			_, _ = w.Write([]byte(`{"id": 12, "environment": "production", "state": "Approved"}`))
+		case http.MethodPut:
+			var state changeRequestStateApiModel
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&state))
+			assert.Equal(t, changeRequestStateApplied, state.State)
+			applied = true
+			_, _ = w.Write([]byte(`{"id": 12, "environment": "production", "state": "Applied"}`))
+		}
+	})
+
+	var diags diag.Diagnostics
+	changeRequest := waitForChangeRequest(context.Background(), client, "default", changeRequestApiModel{Id: 12, State: changeRequestStateInReview}, time.Minute, &diags)
+
+	require.False(t, diags.HasError())
+	assert.True(t, applied)
+	assert.Equal(t, changeRequestStateApplied, changeRequest.State)
+}
+
+func TestWaitForChangeRequestWarnsWhenTimingOut(t *testing.T) {
+	previousInterval := changeRequestPollInterval
+	changeRequestPollInterval = time.Millisecond
+	t.Cleanup(func() { changeRequestPollInterval = previousInterval })
+
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 12, "environment": "production", "state": "In review"}`))
+	})
+
+	var diags diag.Diagnostics
+	changeRequest := waitForChangeRequest(context.Background(), client, "default", changeRequestApiModel{Id: 12, State: changeRequestStateInReview}, 5*time.Millisecond, &diags)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, changeRequestStateInReview, changeRequest.State)
+}
+
+func TestWaitForChangeRequestFailsWhenRejected(t *testing.T) {
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	var diags diag.Diagnostics
+	waitForChangeRequest(context.Background(), client, "default", changeRequestApiModel{Id: 12, State: changeRequestStateRejected}, time.Minute, &diags)
+
+	assert.True(t, diags.HasError())
+}
@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &contextFieldResource{}
 	_ resource.ResourceWithConfigure   = &contextFieldResource{}
 	_ resource.ResourceWithImportState = &contextFieldResource{}
+	_ resource.ResourceWithModifyPlan  = &contextFieldResource{}
 )
 
 func NewContextFieldResource() resource.Resource {
@@ -99,6 +100,10 @@ func (r *contextFieldResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *contextFieldResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
 func (r *contextFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Preparing to import contextField resource")
 
@@ -0,0 +1,447 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &environmentKillSwitchResource{}
+	_ resource.ResourceWithConfigure      = &environmentKillSwitchResource{}
+	_ resource.ResourceWithModifyPlan     = &environmentKillSwitchResource{}
+	_ resource.ResourceWithValidateConfig = &environmentKillSwitchResource{}
+)
+
+func NewEnvironmentKillSwitchResource() resource.Resource {
+	return &environmentKillSwitchResource{}
+}
+
+type environmentKillSwitchResource struct {
+	client *unleash.APIClient
+}
+
+type environmentKillSwitchResourceModel struct {
+	Project          types.String `tfsdk:"project"`
+	Environment      types.String `tfsdk:"environment"`
+	Tag              *tagModel    `tfsdk:"tag"`
+	Engaged          types.Bool   `tfsdk:"engaged"`
+	DisabledFeatures types.List   `tfsdk:"disabled_features"`
+
+	ChangeRequestMode    types.String `tfsdk:"change_request_mode"`
+	ChangeRequestWait    types.Bool   `tfsdk:"change_request_wait"`
+	ChangeRequestTimeout types.String `tfsdk:"change_request_timeout"`
+	ChangeRequestId      types.String `tfsdk:"change_request_id"`
+	ChangeRequestStatus  types.String `tfsdk:"change_request_status"`
+}
+
+func (r *environmentKillSwitchResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *environmentKillSwitchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_kill_switch"
+}
+
+func (r *environmentKillSwitchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Disables every feature of a project in one environment, or every feature with a given tag, and records which ones were enabled. Features enabled again while the kill switch exists are disabled on the next apply. Destroying the kill switch enables the recorded features again. When the environment has change requests enabled, `change_request_mode = \"submit\"` disables and enables the features through a change request instead of writing straight to the API.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project whose features are disabled.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Description: "The environment the features are disabled in. It must be enabled in the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag": schema.SingleNestedAttribute{
+				Description: "Only disable features with this tag. Leave it unset to disable every feature in the project.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The tag type.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The tag value.",
+						Required:    true,
+					},
+				},
+			},
+			"engaged": schema.BoolAttribute{
+				Description: "Whether every matching feature is disabled in the environment.",
+				Computed:    true,
+			},
+			"disabled_features": schema.ListAttribute{
+				Description: "The features the kill switch disabled. These are enabled again when the kill switch is destroyed.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+
+	for name, attribute := range changeRequestResourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (r *environmentKillSwitchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config environmentKillSwitchResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newChangeRequestSettings(config.ChangeRequestMode, config.ChangeRequestWait, config.ChangeRequestTimeout, &resp.Diagnostics)
+}
+
+// ModifyPlan checks the environment while planning and plans to disable any matching feature that was enabled again.
+// It also warns when the write would skip change requests the environment requires.
+func (r *environmentKillSwitchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan environmentKillSwitchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Project.IsUnknown() || plan.Environment.IsUnknown() {
+		return
+	}
+
+	enabled, ok := projectEnvironmentIsEnabled(ctx, r.client, plan.Project.ValueString(), plan.Environment.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if !enabled {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment"),
+			"Environment not enabled",
+			fmt.Sprintf("Environment %s is not enabled in project %s.", plan.Environment.ValueString(), plan.Project.ValueString()),
+		)
+		return
+	}
+
+	warnAboutDirectWrites(ctx, r.client, plan.Project.ValueString(), plan.Environment.ValueString(), plan.ChangeRequestMode, "to the features", &resp.Diagnostics)
+
+	var state *environmentKillSwitchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a change request that still has to disable the features is left to do its work
+	if state != nil && !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+		return
+	}
+
+	// a submitted change request only engages the kill switch once it's applied
+	if plan.ChangeRequestMode.ValueString() == changeRequestModeSubmit {
+		if state == nil || !state.Engaged.ValueBool() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("engaged"), types.BoolUnknown())...)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("engaged"), types.BoolValue(true))...)
+}
+
+func (r *environmentKillSwitchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create environment kill switch resource")
+	var plan environmentKillSwitchResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disabled, ok := r.disable(ctx, &plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	slices.Sort(disabled)
+	disabledFeatures, diags := types.ListValueFrom(ctx, types.StringType, disabled)
+	resp.Diagnostics.Append(diags...)
+	plan.Engaged = types.BoolValue(!isChangeRequestPending(plan.ChangeRequestStatus.ValueString()))
+	plan.DisabledFeatures = disabledFeatures
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating environment kill switch resource", map[string]any{"success": true})
+}
+
+func (r *environmentKillSwitchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read environment kill switch resource")
+	var state environmentKillSwitchResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultChangeRequestAttributes(&state.ChangeRequestMode, &state.ChangeRequestWait, &state.ChangeRequestTimeout)
+
+	if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+		var changeRequest changeRequestApiModel
+		httpRes, err := fetchChangeRequest(ctx, r.client, state.Project.ValueString(), state.ChangeRequestId.ValueString(), &changeRequest)
+		if isNotFoundResponse(httpRes) {
+			changeRequest.State = changeRequestStateCanceled
+		} else if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+		state.ChangeRequestStatus = types.StringValue(changeRequest.State)
+
+		// until the change request is done, the features are still enabled on purpose
+		if isChangeRequestPending(changeRequest.State) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	var features projectFeaturesApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, projectFeaturesPath(state.Project.ValueString()), nil, &features)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Project.ValueString(), "Project") {
+		return
+	}
+
+	state.Engaged = types.BoolValue(len(state.matching(features)) == 0)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading environment kill switch resource", map[string]any{"success": true})
+}
+
+func (r *environmentKillSwitchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update environment kill switch resource")
+	var plan environmentKillSwitchResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state environmentKillSwitchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the features are disabled once the open change request is applied, submitting another one would only repeat it
+	if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+		plan.Engaged = state.Engaged
+		plan.DisabledFeatures = state.DisabledFeatures
+		plan.ChangeRequestId = state.ChangeRequestId
+		plan.ChangeRequestStatus = state.ChangeRequestStatus
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	var recorded []string
+	resp.Diagnostics.Append(state.DisabledFeatures.ElementsAs(ctx, &recorded, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disabled, ok := r.disable(ctx, &plan, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// features enabled again during the incident are restored along with the rest
+	for _, feature := range disabled {
+		if !slices.Contains(recorded, feature) {
+			recorded = append(recorded, feature)
+		}
+	}
+	slices.Sort(recorded)
+
+	disabledFeatures, diags := types.ListValueFrom(ctx, types.StringType, recorded)
+	resp.Diagnostics.Append(diags...)
+	plan.Engaged = types.BoolValue(!isChangeRequestPending(plan.ChangeRequestStatus.ValueString()))
+	plan.DisabledFeatures = disabledFeatures
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating environment kill switch resource", map[string]any{"success": true})
+}
+
+func (r *environmentKillSwitchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete environment kill switch resource")
+	var state environmentKillSwitchResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var recorded []string
+	resp.Diagnostics.Append(state.DisabledFeatures.ElementsAs(ctx, &recorded, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+		resp.Diagnostics.AddWarning(
+			"Change request still open",
+			fmt.Sprintf("Change request %s that disables the features is still open in Unleash. Reject or cancel it there to keep the features enabled.", state.ChangeRequestId.ValueString()),
+		)
+	}
+
+	var features projectFeaturesApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, projectFeaturesPath(state.Project.ValueString()), nil, &features)
+	if isNotFoundResponse(httpRes) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	// features archived in the meantime can't be enabled again
+	restore := []string{}
+	for _, feature := range features.Features {
+		if slices.Contains(recorded, feature.Name) && !feature.enabledIn(state.Environment.ValueString()) {
+			restore = append(restore, feature.Name)
+		}
+	}
+
+	if len(restore) > 0 {
+		if !r.toggle(ctx, &state, true, restore, &resp.Diagnostics) {
+			return
+		}
+
+		if isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+			resp.Diagnostics.AddWarning(
+				"Enabling features waiting for change request",
+				fmt.Sprintf("The features stay disabled in %s until change request %s is applied.", state.Environment.ValueString(), state.ChangeRequestId.ValueString()),
+			)
+		} else {
+			tflog.Info(ctx, fmt.Sprintf("Enabled %d features again in %s of project %s", len(restore), state.Environment.ValueString(), state.Project.ValueString()))
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting environment kill switch resource", map[string]any{"success": true})
+}
+
+// disable turns off every matching feature that is enabled and returns their names.
+func (r *environmentKillSwitchResource) disable(ctx context.Context, model *environmentKillSwitchResourceModel, diagnostics *diag.Diagnostics) ([]string, bool) {
+	var features projectFeaturesApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, projectFeaturesPath(model.Project.ValueString()), nil, &features)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return nil, false
+	}
+
+	model.ChangeRequestId = types.StringNull()
+	model.ChangeRequestStatus = types.StringNull()
+
+	enabled := model.matching(features)
+	if len(enabled) == 0 {
+		return enabled, true
+	}
+
+	if !r.toggle(ctx, model, false, enabled, diagnostics) {
+		return nil, false
+	}
+
+	if isChangeRequestPending(model.ChangeRequestStatus.ValueString()) {
+		diagnostics.AddWarning(
+			"Kill switch waiting for change request",
+			fmt.Sprintf("The features stay enabled in %s until change request %s is applied.", model.Environment.ValueString(), model.ChangeRequestId.ValueString()),
+		)
+		return enabled, true
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Disabled %d features in %s of project %s", len(enabled), model.Environment.ValueString(), model.Project.ValueString()))
+	return enabled, true
+}
+
+// toggle turns the features on or off. In submit mode for environments with change requests enabled, it opens a change
+// request instead and records it on the model.
+func (r *environmentKillSwitchResource) toggle(ctx context.Context, model *environmentKillSwitchResourceModel, enabled bool, features []string, diagnostics *diag.Diagnostics) bool {
+	settings := newChangeRequestSettings(model.ChangeRequestMode, model.ChangeRequestWait, model.ChangeRequestTimeout, diagnostics)
+	environment, ok := changeRequestEnvironment(ctx, r.client, model.Project.ValueString(), model.Environment.ValueString(), settings, diagnostics)
+	if !ok || diagnostics.HasError() {
+		return false
+	}
+
+	model.ChangeRequestId = types.StringNull()
+	model.ChangeRequestStatus = types.StringNull()
+
+	if environment != "" {
+		changes := make([]changeRequestChangeApiModel, 0, len(features))
+		for _, feature := range features {
+			changes = append(changes, changeRequestChangeApiModel{
+				Feature: feature,
+				Action:  "updateEnabled",
+				Payload: map[string]bool{"enabled": enabled},
+			})
+		}
+		changeRequest := submitChangeRequest(ctx, r.client, model.Project.ValueString(), environment, changes, settings, diagnostics)
+		if changeRequest == nil || diagnostics.HasError() {
+			return false
+		}
+
+		model.ChangeRequestId = types.StringValue(strconv.FormatInt(changeRequest.Id, 10))
+		model.ChangeRequestStatus = types.StringValue(changeRequest.State)
+		return true
+	}
+
+	state := "off"
+	if enabled {
+		state = "on"
+	}
+	togglePath := "/api/admin/projects/" + url.PathEscape(model.Project.ValueString()) + "/bulk_features/environments/" + url.PathEscape(model.Environment.ValueString()) + "/" + state
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, togglePath, bulkToggleFeaturesApiModel{Features: features}, nil)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+// matching returns the features the kill switch covers that are enabled in its environment.
+func (m *environmentKillSwitchResourceModel) matching(features projectFeaturesApiModel) []string {
+	enabled := []string{}
+	for _, feature := range features.Features {
+		if m.Tag != nil && !feature.hasTag(tagApiModel{Type: m.Tag.Type.ValueString(), Value: m.Tag.Value.ValueString()}) {
+			continue
+		}
+		if feature.enabledIn(m.Environment.ValueString()) {
+			enabled = append(enabled, feature.Name)
+		}
+	}
+	return enabled
+}
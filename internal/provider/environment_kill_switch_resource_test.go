@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// testAccEnableFeature turns a feature on in an environment directly through the API.
+func testAccEnableFeature(t *testing.T, project string, feature string, environment string) {
+	t.Helper()
+
+	if _, err := adminApiRequest(context.Background(), testAccClient(t), http.MethodPost, featureEnvironmentPath(project, feature, environment)+"/on", nil, nil); err != nil {
+		t.Fatalf("Failed to enable feature %s in %s: %v", feature, environment, err)
+	}
+}
+
+func testAccCheckFeatureEnabled(t *testing.T, project string, feature string, environment string, expected bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var features projectFeaturesApiModel
+		httpRes, err := adminApiRequest(context.Background(), testAccClient(t), http.MethodGet, projectFeaturesPath(project), nil, &features)
+		if err != nil || httpRes.StatusCode != http.StatusOK {
+			return fmt.Errorf("failed to read features of project %s: %v", project, err)
+		}
+
+		for _, candidate := range features.Features {
+			if candidate.Name == feature {
+				if candidate.enabledIn(environment) != expected {
+					return fmt.Errorf("expected feature %s to be enabled=%t in %s", feature, expected, environment)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("feature %s not found in project %s", feature, project)
+	}
+}
+
+func TestAccEnvironmentKillSwitchResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-kill-payments")
+			testAccCreateFeature(t, "default", "tf-kill-search")
+			testAccEnableFeature(t, "default", "tf-kill-payments", "development")
+			testAccEnableFeature(t, "default", "tf-kill-search", "development")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckFeatureEnabled(t, "default", "tf-kill-payments", "development", true),
+			testAccCheckFeatureEnabled(t, "default", "tf-kill-search", "development", true),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_tag_type" "incident" {
+						name = "tf-incident"
+					}
+
+					resource "unleash_feature_tags" "payments" {
+						feature = "tf-kill-payments"
+						tags = [
+							{
+								type  = unleash_tag_type.incident.name
+								value = "payments"
+							}
+						]
+					}
+
+					resource "unleash_environment_kill_switch" "payments" {
+						project     = "default"
+						environment = "development"
+						tag = {
+							type  = unleash_tag_type.incident.name
+							value = "payments"
+						}
+
+						depends_on = [unleash_feature_tags.payments]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_environment_kill_switch.payments", "engaged", "true"),
+					resource.TestCheckResourceAttr("unleash_environment_kill_switch.payments", "disabled_features.#", "1"),
+					resource.TestCheckResourceAttr("unleash_environment_kill_switch.payments", "disabled_features.0", "tf-kill-payments"),
+					testAccCheckFeatureEnabled(t, "default", "tf-kill-payments", "development", false),
+					testAccCheckFeatureEnabled(t, "default", "tf-kill-search", "development", true),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironmentKillSwitchMatching(t *testing.T) {
+	features := projectFeaturesApiModel{Features: []projectFeatureApiModel{
+		{
+			Name:         "checkout",
+			Environments: []featureEnvironmentApiModel{{Name: "production", Enabled: true}},
+			Tags:         []tagApiModel{{Type: "simple", Value: "payments"}},
+		},
+		{
+			Name:         "search",
+			Environments: []featureEnvironmentApiModel{{Name: "production", Enabled: true}, {Name: "development", Enabled: false}},
+		},
+		{
+			Name:         "refunds",
+			Environments: []featureEnvironmentApiModel{{Name: "production", Enabled: false}},
+			Tags:         []tagApiModel{{Type: "simple", Value: "payments"}},
+		},
+	}}
+
+	everything := environmentKillSwitchResourceModel{Environment: types.StringValue("production")}
+	assert.Equal(t, []string{"checkout", "search"}, everything.matching(features))
+
+	tagged := environmentKillSwitchResourceModel{
+		Environment: types.StringValue("production"),
+		Tag:         &tagModel{Type: types.StringValue("simple"), Value: types.StringValue("payments")},
+	}
+	assert.Equal(t, []string{"checkout"}, tagged.matching(features))
+
+	development := environmentKillSwitchResourceModel{Environment: types.StringValue("development")}
+	assert.Empty(t, development.matching(features))
+}
+
+func TestEnvironmentKillSwitchToggleSubmitsChangeRequest(t *testing.T) {
+	var requests []string
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/admin/projects/default/change-requests/config":
+			_, _ = w.Write([]byte(`[{"environment": "production", "type": "production", "changeRequestEnabled": true, "requiredApprovals": 1}]`))
+		case "POST /api/admin/projects/default/environments/production/change-requests":
+			var changes []changeRequestChangeApiModel
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&changes))
+			require.Len(t, changes, 2)
+			assert.Equal(t, "checkout", changes[0].Feature)
+			assert.Equal(t, "updateEnabled", changes[0].Action)
+			assert.Equal(t, map[string]any{"enabled": false}, changes[0].Payload)
+			_, _ = w.Write([]byte(`{"id": 7, "environment": "production", "state": "Draft", "features": [
+				{"name": "checkout", "changes": [{"id": 1, "action": "updateEnabled", "payload": {"enabled": false}}]},
+				{"name": "search", "changes": [{"id": 2, "action": "updateEnabled", "payload": {"enabled": false}}]}
+			], "segments": []}`))
+		case "PUT /api/admin/projects/default/change-requests/7/state":
+			_, _ = w.Write([]byte(`{"id": 7, "environment": "production", "state": "In review"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	killSwitch := &environmentKillSwitchResource{client: client}
+
+	model := environmentKillSwitchResourceModel{
+		Project:           types.StringValue("default"),
+		Environment:       types.StringValue("production"),
+		ChangeRequestMode: types.StringValue(changeRequestModeSubmit),
+	}
+	var diags diag.Diagnostics
+	ok := killSwitch.toggle(context.Background(), &model, false, []string{"checkout", "search"}, &diags)
+
+	require.True(t, ok)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "7", model.ChangeRequestId.ValueString())
+	assert.Equal(t, changeRequestStateInReview, model.ChangeRequestStatus.ValueString())
+	assert.NotContains(t, requests, "POST /api/admin/projects/default/bulk_features/environments/production/off")
+}
@@ -17,6 +17,7 @@ var (
 	_ resource.Resource                = &environmentResource{}
 	_ resource.ResourceWithConfigure   = &environmentResource{}
 	_ resource.ResourceWithImportState = &environmentResource{}
+	_ resource.ResourceWithModifyPlan  = &environmentResource{}
 )
 
 func NewEnvironmentResource() resource.Resource {
@@ -69,6 +70,10 @@ func (r *environmentResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+func (r *environmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
 func (r *environmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Preparing to import environment resource")
 
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// featureSearchPageSize is how many features are fetched per request when searching.
+const featureSearchPageSize = 100
+
+// featureApiModel holds the parts of the Unleash feature flag payload the provider reads.
+type featureApiModel struct {
+	Name           string                       `json:"name"`
+	Project        string                       `json:"project"`
+	Type           string                       `json:"type"`
+	Description    *string                      `json:"description"`
+	Stale          bool                         `json:"stale"`
+	ImpressionData bool                         `json:"impressionData"`
+	LastSeenAt     *string                      `json:"lastSeenAt"`
+	Environments   []featureEnvironmentApiModel `json:"environments"`
+	Children       []string                     `json:"children"`
+	Dependencies   []featureDependencyApiModel  `json:"dependencies"`
+	Links          []featureLinkApiModel        `json:"links"`
+}
+
+// projectFeaturesApiModel is the list of features in a project, with their state in every environment.
+type projectFeaturesApiModel struct {
+	Features []projectFeatureApiModel `json:"features"`
+}
+
+type projectFeatureApiModel struct {
+	Name         string                       `json:"name"`
+	Type         string                       `json:"type"`
+	Stale        bool                         `json:"stale"`
+	CreatedAt    string                       `json:"createdAt"`
+	LastSeenAt   *string                      `json:"lastSeenAt"`
+	Environments []featureEnvironmentApiModel `json:"environments"`
+	Tags         []tagApiModel                `json:"tags"`
+}
+
+type featureEnvironmentApiModel struct {
+	Name       string                    `json:"name"`
+	Type       string                    `json:"type,omitempty"`
+	Enabled    bool                      `json:"enabled"`
+	LastSeenAt *string                   `json:"lastSeenAt,omitempty"`
+	Strategies []featureStrategyApiModel `json:"strategies,omitempty"`
+}
+
+type bulkToggleFeaturesApiModel struct {
+	Features []string `json:"features"`
+}
+
+type featureSearchResultApiModel struct {
+	Features []featureSearchApiModel `json:"features"`
+	Total    int64                   `json:"total"`
+}
+
+type featureSearchApiModel struct {
+	Name           string                       `json:"name"`
+	Project        string                       `json:"project"`
+	Type           string                       `json:"type"`
+	Description    *string                      `json:"description"`
+	Stale          bool                         `json:"stale"`
+	ImpressionData bool                         `json:"impressionData"`
+	CreatedAt      *string                      `json:"createdAt"`
+	LastSeenAt     *string                      `json:"lastSeenAt"`
+	Environments   []featureEnvironmentApiModel `json:"environments"`
+	Tags           []tagApiModel                `json:"tags"`
+}
+
+type featureDependencyApiModel struct {
+	Feature  string   `json:"feature"`
+	Enabled  *bool    `json:"enabled,omitempty"`
+	Variants []string `json:"variants"`
+}
+
+type featureLinkApiModel struct {
+	Id    string  `json:"id,omitempty"`
+	Url   string  `json:"url"`
+	Title *string `json:"title,omitempty"`
+}
+
+func featurePath(project string, feature string) string {
+	return "/api/admin/projects/" + url.PathEscape(project) + "/features/" + url.PathEscape(feature)
+}
+
+func featureEnvironmentPath(project string, feature string, environment string) string {
+	return featurePath(project, feature) + "/environments/" + url.PathEscape(environment)
+}
+
+func projectFeaturesPath(project string) string {
+	return "/api/admin/projects/" + url.PathEscape(project) + "/features"
+}
+
+// enabledIn reports whether the feature is enabled in an environment.
+func (f projectFeatureApiModel) enabledIn(environment string) bool {
+	for _, featureEnvironment := range f.Environments {
+		if featureEnvironment.Name == environment {
+			return featureEnvironment.Enabled
+		}
+	}
+	return false
+}
+
+func (f projectFeatureApiModel) hasTag(tag tagApiModel) bool {
+	for _, featureTag := range f.Tags {
+		if featureTag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// searchFeatures returns every feature matching the search parameters, fetching as many pages as needed.
+func searchFeatures(ctx context.Context, client *unleash.APIClient, parameters url.Values, diagnostics *diag.Diagnostics) ([]featureSearchApiModel, bool) {
+	features := []featureSearchApiModel{}
+
+	for offset := 0; ; offset += featureSearchPageSize {
+		query := url.Values{}
+		for key, values := range parameters {
+			query[key] = values
+		}
+		query.Set("offset", strconv.Itoa(offset))
+		query.Set("limit", strconv.Itoa(featureSearchPageSize))
+
+		var page featureSearchResultApiModel
+		httpRes, err := adminApiRequest(ctx, client, http.MethodGet, "/api/admin/search/features?"+query.Encode(), nil, &page)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return nil, false
+		}
+
+		features = append(features, page.Features...)
+		if len(page.Features) < featureSearchPageSize || (page.Total > 0 && int64(len(features)) >= page.Total) {
+			return features, true
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &featureDependencyResource{}
+	_ resource.ResourceWithConfigure      = &featureDependencyResource{}
+	_ resource.ResourceWithImportState    = &featureDependencyResource{}
+	_ resource.ResourceWithValidateConfig = &featureDependencyResource{}
+	_ resource.ResourceWithModifyPlan     = &featureDependencyResource{}
+)
+
+func NewFeatureDependencyResource() resource.Resource {
+	return &featureDependencyResource{}
+}
+
+type featureDependencyResource struct {
+	client *unleash.APIClient
+}
+
+type featureDependencyResourceModel struct {
+	Project  types.String `tfsdk:"project"`
+	Child    types.String `tfsdk:"child"`
+	Parent   types.String `tfsdk:"parent"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Variants types.List   `tfsdk:"variants"`
+
+	ChangeRequestMode    types.String `tfsdk:"change_request_mode"`
+	ChangeRequestWait    types.Bool   `tfsdk:"change_request_wait"`
+	ChangeRequestTimeout types.String `tfsdk:"change_request_timeout"`
+	ChangeRequestId      types.String `tfsdk:"change_request_id"`
+	ChangeRequestStatus  types.String `tfsdk:"change_request_status"`
+}
+
+func (r *featureDependencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *featureDependencyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_dependency"
+}
+
+func (r *featureDependencyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a dependency between two feature flags in the same project. The child feature is only enabled when the parent feature is enabled (or disabled) and, optionally, resolves to one of the listed variants. When the project has change requests enabled, `change_request_mode = \"submit\"` sends changes through a change request in the first protected environment.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project both features belong to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"child": schema.StringAttribute{
+				Description: "The name of the feature that depends on the parent.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent": schema.StringAttribute{
+				Description: "The name of the feature the child depends on. The parent must exist in the same project and can't be a child of another feature.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the parent feature must be enabled (true) or disabled (false) for the child to be enabled. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"variants": schema.ListAttribute{
+				Description: "The variants the parent feature must resolve to. Leave empty to only check whether the parent is enabled. Only valid when enabled is true.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+
+	for name, attribute := range changeRequestResourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (r *featureDependencyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config featureDependencyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Child.IsUnknown() && !config.Parent.IsUnknown() && !config.Child.IsNull() && config.Child.ValueString() == config.Parent.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent"),
+			"Invalid feature dependency",
+			fmt.Sprintf("Feature %s can't depend on itself.", config.Child.ValueString()),
+		)
+	}
+
+	if !config.Enabled.IsNull() && !config.Enabled.IsUnknown() && !config.Enabled.ValueBool() &&
+		!config.Variants.IsNull() && !config.Variants.IsUnknown() && len(config.Variants.Elements()) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("variants"),
+			"Invalid feature dependency",
+			"variants can only be set when enabled is true.",
+		)
+	}
+
+	newChangeRequestSettings(config.ChangeRequestMode, config.ChangeRequestWait, config.ChangeRequestTimeout, &resp.Diagnostics)
+}
+
+// ModifyPlan checks the parent feature while planning, so invalid dependencies are reported before anything is applied.
+// It also warns when the write would skip change requests the project requires.
+func (r *featureDependencyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan featureDependencyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Project.IsUnknown() || plan.Parent.IsUnknown() {
+		return
+	}
+
+	var parent featureApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featurePath(plan.Project.ValueString(), plan.Parent.ValueString()), nil, &parent)
+	if isNotFoundResponse(httpRes) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent"),
+			"Parent feature not found",
+			fmt.Sprintf("Feature %s does not exist in project %s. Dependencies can only be created between features in the same project.", plan.Parent.ValueString(), plan.Project.ValueString()),
+		)
+		return
+	}
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	if len(parent.Dependencies) > 0 {
+		parents := make([]string, 0, len(parent.Dependencies))
+		for _, dependency := range parent.Dependencies {
+			parents = append(parents, dependency.Feature)
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent"),
+			"Parent feature is itself a child",
+			fmt.Sprintf("Feature %s depends on %s. Unleash only supports one level of dependencies, so it can't be used as a parent.", plan.Parent.ValueString(), strings.Join(parents, ", ")),
+		)
+		return
+	}
+
+	if req.State.Raw.IsNull() || !req.Plan.Raw.Equal(req.State.Raw) {
+		warnAboutDirectWrites(ctx, r.client, plan.Project.ValueString(), "", plan.ChangeRequestMode, "this dependency", &resp.Diagnostics)
+	}
+}
+
+func (r *featureDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import feature dependency resource")
+
+	// The unique identifier for a feature dependency is: "<project>:<child>:<parent>"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format '<project>:<child>:<parent>'. Example: 'default:new-checkout:checkout-api'",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("child"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent"), parts[2])...)
+
+	tflog.Debug(ctx, "Finished importing feature dependency resource", map[string]any{"success": true})
+}
+
+func (r *featureDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create feature dependency resource")
+	var plan featureDependencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.upsert(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating feature dependency resource", map[string]any{"success": true})
+}
+
+func (r *featureDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature dependency resource")
+	var state featureDependencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultChangeRequestAttributes(&state.ChangeRequestMode, &state.ChangeRequestWait, &state.ChangeRequestTimeout)
+
+	if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+		var changeRequest changeRequestApiModel
+		httpRes, err := fetchChangeRequest(ctx, r.client, state.Project.ValueString(), state.ChangeRequestId.ValueString(), &changeRequest)
+		if isNotFoundResponse(httpRes) {
+			changeRequest.State = changeRequestStateCanceled
+		} else if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+		state.ChangeRequestStatus = types.StringValue(changeRequest.State)
+
+		// until the change request is done, the dependency in Unleash doesn't reflect the configuration yet
+		if isChangeRequestPending(changeRequest.State) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	var child featureApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featurePath(state.Project.ValueString(), state.Child.ValueString()), nil, &child)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Child.ValueString(), "Feature") {
+		return
+	}
+
+	var dependency *featureDependencyApiModel
+	for i := range child.Dependencies {
+		if child.Dependencies[i].Feature == state.Parent.ValueString() {
+			dependency = &child.Dependencies[i]
+			break
+		}
+	}
+
+	if dependency == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Feature %s no longer depends on %s, removing from state", state.Child.ValueString(), state.Parent.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.hydrateFromApi(ctx, *dependency, resp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading feature dependency resource", map[string]any{"success": true})
+}
+
+func (r *featureDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update feature dependency resource")
+	var plan featureDependencyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state featureDependencyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only the change request settings changed, there's nothing to write
+	if plan.Enabled.Equal(state.Enabled) && plan.Variants.Equal(state.Variants) {
+		plan.ChangeRequestId = state.ChangeRequestId
+		plan.ChangeRequestStatus = state.ChangeRequestStatus
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// adding a dependency on a parent the child already depends on replaces it
+	if !r.upsert(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating feature dependency resource", map[string]any{"success": true})
+}
+
+func (r *featureDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete feature dependency resource")
+	var state featureDependencyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := newChangeRequestSettings(state.ChangeRequestMode, state.ChangeRequestWait, state.ChangeRequestTimeout, &resp.Diagnostics)
+	environment, ok := changeRequestEnvironment(ctx, r.client, state.Project.ValueString(), "", settings, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if environment != "" {
+		change := changeRequestChangeApiModel{
+			Feature: state.Child.ValueString(),
+			Action:  "deleteDependency",
+			Payload: map[string]string{"feature": state.Parent.ValueString()},
+		}
+		changeRequest := submitChangeRequest(ctx, r.client, state.Project.ValueString(), environment, []changeRequestChangeApiModel{change}, settings, &resp.Diagnostics)
+		if changeRequest == nil || resp.Diagnostics.HasError() {
+			return
+		}
+
+		if changeRequest.State != changeRequestStateApplied {
+			resp.Diagnostics.AddWarning(
+				"Dependency removal waiting for change request",
+				fmt.Sprintf("Feature %s keeps depending on %s until change request %d is applied.", state.Child.ValueString(), state.Parent.ValueString(), changeRequest.Id),
+			)
+		}
+	} else {
+		dependencyPath := featurePath(state.Project.ValueString(), state.Child.ValueString()) + "/dependencies/" + url.PathEscape(state.Parent.ValueString())
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, dependencyPath, nil, nil)
+		if !isNotFoundResponse(httpRes) && !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting feature dependency resource", map[string]any{"success": true})
+}
+
+// upsert adds the dependency, or replaces it when it already exists. In submit mode for projects with change requests
+// enabled, the change is sent as a change request instead.
+func (r *featureDependencyResource) upsert(ctx context.Context, plan *featureDependencyResourceModel, diagnostics *diag.Diagnostics) bool {
+	request := featureDependencyApiModel{
+		Feature:  plan.Parent.ValueString(),
+		Enabled:  plan.Enabled.ValueBoolPointer(),
+		Variants: []string{},
+	}
+	if !plan.Variants.IsNull() && !plan.Variants.IsUnknown() {
+		diagnostics.Append(plan.Variants.ElementsAs(ctx, &request.Variants, false)...)
+		if diagnostics.HasError() {
+			return false
+		}
+	}
+
+	settings := newChangeRequestSettings(plan.ChangeRequestMode, plan.ChangeRequestWait, plan.ChangeRequestTimeout, diagnostics)
+	environment, ok := changeRequestEnvironment(ctx, r.client, plan.Project.ValueString(), "", settings, diagnostics)
+	if !ok || diagnostics.HasError() {
+		return false
+	}
+
+	plan.ChangeRequestId = types.StringNull()
+	plan.ChangeRequestStatus = types.StringNull()
+
+	if environment != "" {
+		change := changeRequestChangeApiModel{
+			Feature: plan.Child.ValueString(),
+			Action:  "addDependency",
+			Payload: request,
+		}
+		changeRequest := submitChangeRequest(ctx, r.client, plan.Project.ValueString(), environment, []changeRequestChangeApiModel{change}, settings, diagnostics)
+		if changeRequest == nil || diagnostics.HasError() {
+			return false
+		}
+
+		plan.ChangeRequestId = types.StringValue(strconv.FormatInt(changeRequest.Id, 10))
+		plan.ChangeRequestStatus = types.StringValue(changeRequest.State)
+		return true
+	}
+
+	dependencyPath := featurePath(plan.Project.ValueString(), plan.Child.ValueString()) + "/dependencies"
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, dependencyPath, request, nil)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+func (m *featureDependencyResourceModel) hydrateFromApi(ctx context.Context, dependency featureDependencyApiModel, resp *resource.ReadResponse) {
+	m.Parent = types.StringValue(dependency.Feature)
+
+	if dependency.Enabled != nil {
+		m.Enabled = types.BoolValue(*dependency.Enabled)
+	} else {
+		m.Enabled = types.BoolValue(true)
+	}
+
+	variants := dependency.Variants
+	if variants == nil {
+		variants = []string{}
+	}
+	variantsList, diags := types.ListValueFrom(ctx, types.StringType, variants)
+	resp.Diagnostics.Append(diags...)
+	m.Variants = variantsList
+}
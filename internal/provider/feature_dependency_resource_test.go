@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccClient returns a client for setting up what the provider doesn't manage, like feature flags.
+func testAccClient(t *testing.T) *unleash.APIClient {
+	t.Helper()
+
+	config := &UnleashConfiguration{
+		BaseUrl:       types.StringNull(),
+		Authorization: types.StringNull(),
+	}
+
+	var diagnostics diag.Diagnostics
+	client := unleashClient(context.Background(), &UnleashProvider{version: "test"}, config, &diagnostics)
+	if diagnostics.HasError() {
+		t.Fatalf("Failed to create test client: %v", diagnostics.Errors())
+	}
+	return client
+}
+
+// testAccCreateFeature creates a feature flag directly through the API and archives it again once the test is done.
+// The provider doesn't manage feature flags themselves, so tests that need one create it this way.
+func testAccCreateFeature(t *testing.T, project string, name string) {
+	t.Helper()
+
+	client := testAccClient(t)
+	ctx := context.Background()
+	body := map[string]any{"name": name, "type": "release"}
+	if _, err := adminApiRequest(ctx, client, http.MethodPost, "/api/admin/projects/"+project+"/features", body, nil); err != nil {
+		t.Fatalf("Failed to create feature %s: %v", name, err)
+	}
+
+	t.Cleanup(func() {
+		_, _ = adminApiRequest(ctx, client, http.MethodDelete, featurePath(project, name), nil, nil)
+		_, _ = adminApiRequest(ctx, client, http.MethodDelete, "/api/admin/archive/"+name, nil, nil)
+	})
+}
+
+func TestAccFeatureDependencyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-dependency-parent")
+			testAccCreateFeature(t, "default", "tf-dependency-child")
+			testAccCreateFeature(t, "default", "tf-dependency-grandchild")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_feature_dependency" "child" {
+						project = "default"
+						child   = "tf-dependency-child"
+						parent  = "tf-dependency-parent"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_dependency.child", "project", "default"),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.child", "child", "tf-dependency-child"),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.child", "parent", "tf-dependency-parent"),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.child", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.child", "variants.#", "0"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_feature_dependency" "child" {
+						project = "default"
+						child   = "tf-dependency-child"
+						parent  = "tf-dependency-parent"
+						enabled = false
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_dependency.child", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:                         "unleash_feature_dependency.child",
+				ImportState:                          true,
+				ImportStateId:                        "default:tf-dependency-child:tf-dependency-parent",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "child",
+			},
+			{
+				Config: `
+					resource "unleash_feature_dependency" "child" {
+						project = "default"
+						child   = "tf-dependency-child"
+						parent  = "tf-dependency-parent"
+						enabled = false
+					}
+
+					resource "unleash_feature_dependency" "grandchild" {
+						project = "default"
+						child   = "tf-dependency-grandchild"
+						parent  = "tf-dependency-child"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Parent feature is itself a child"),
+			},
+			{
+				Config: `
+					resource "unleash_feature_dependency" "missing" {
+						project = "default"
+						child   = "tf-dependency-child"
+						parent  = "tf-dependency-does-not-exist"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Parent feature not found"),
+			},
+			{
+				Config: `
+					resource "unleash_feature_dependency" "invalid" {
+						project  = "default"
+						child    = "tf-dependency-child"
+						parent   = "tf-dependency-parent"
+						enabled  = false
+						variants = ["blue"]
+					}
+				`,
+				ExpectError: regexp.MustCompile("variants can only be set when enabled is true"),
+			},
+		},
+	})
+}
+
+func TestAccFeatureDependencyResourceChangeRequest(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-reviewed-parent")
+			testAccCreateFeature(t, "default", "tf-reviewed-child")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_project_environment" "production" {
+						project_id              = "default"
+						environment_name        = "production"
+						change_requests_enabled = true
+						required_approvals      = 1
+					}
+
+					resource "unleash_feature_dependency" "reviewed" {
+						project             = "default"
+						child               = "tf-reviewed-child"
+						parent              = "tf-reviewed-parent"
+						change_request_mode = "submit"
+
+						depends_on = [unleash_project_environment.production]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_dependency.reviewed", "change_request_mode", "submit"),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.reviewed", "change_request_wait", "false"),
+					resource.TestCheckResourceAttrSet("unleash_feature_dependency.reviewed", "change_request_id"),
+					resource.TestCheckResourceAttr("unleash_feature_dependency.reviewed", "change_request_status", "In review"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &featureExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &featureExportDataSource{}
+)
+
+// emptyFeatureExport is what Unleash exports when nothing matches.
+const emptyFeatureExport = `{"features":[],"featureStrategies":[],"featureEnvironments":[],"contextFields":[],"featureTags":[],"segments":[],"tagTypes":[],"dependencies":[]}`
+
+func NewFeatureExportDataSource() datasource.DataSource {
+	return &featureExportDataSource{}
+}
+
+type featureExportDataSource struct {
+	client *unleash.APIClient
+}
+
+type featureExportDataSourceModel struct {
+	Project     types.String   `tfsdk:"project"`
+	Environment types.String   `tfsdk:"environment"`
+	Tag         *tagModel      `tfsdk:"tag"`
+	Query       types.String   `tfsdk:"query"`
+	Features    []types.String `tfsdk:"features"`
+	Document    types.String   `tfsdk:"document"`
+}
+
+// featureExportApiModel holds the parts of an export document the provider reads.
+type featureExportApiModel struct {
+	Features []struct {
+		Name string `json:"name"`
+	} `json:"features"`
+}
+
+func (d *featureExportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *featureExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_export"
+}
+
+func (d *featureExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Export the features of a project in one environment, in the format Unleash uses for importing features. The export includes strategies, segments, tags, context fields and dependencies, and can be passed to `unleash_feature_import`.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project to export features from.",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "The environment to export the strategies and enabled state from.",
+				Required:    true,
+			},
+			"tag": schema.SingleNestedAttribute{
+				Description: "Only export features with this tag.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The tag type.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The tag value.",
+						Required:    true,
+					},
+				},
+			},
+			"query": schema.StringAttribute{
+				Description: "Only export features whose name or description contains this text.",
+				Optional:    true,
+			},
+			"features": schema.ListAttribute{
+				Description: "The names of the exported features.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"document": schema.StringAttribute{
+				Description: "The export as a JSON document.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *featureExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature export data source")
+	var state featureExportDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := map[string]any{"environment": state.Environment.ValueString()}
+	if state.Tag == nil && state.Query.IsNull() {
+		request["project"] = state.Project.ValueString()
+	} else {
+		// exports can't combine filters, so the features are looked up first and exported by name
+		parameters := url.Values{}
+		parameters.Set("project", "IS:"+state.Project.ValueString())
+		if state.Tag != nil {
+			parameters.Set("tag", "INCLUDE:"+state.Tag.Type.ValueString()+":"+state.Tag.Value.ValueString())
+		}
+		if !state.Query.IsNull() {
+			parameters.Set("query", state.Query.ValueString())
+		}
+
+		features, ok := searchFeatures(ctx, d.client, parameters, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+
+		names := make([]string, 0, len(features))
+		for _, feature := range features {
+			names = append(names, feature.Name)
+		}
+		request["features"] = names
+	}
+
+	document := json.RawMessage(emptyFeatureExport)
+	if names, ok := request["features"].([]string); !ok || len(names) > 0 {
+		httpRes, err := adminApiRequest(ctx, d.client, http.MethodPost, "/api/admin/features-batch/export", request, &document)
+		if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+	}
+
+	export, err := parseFeatureExport(string(document))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read export", err.Error())
+		return
+	}
+
+	state.Features = make([]types.String, 0, len(export.Features))
+	for _, feature := range export.Features {
+		state.Features = append(state.Features, types.StringValue(feature.Name))
+	}
+	state.Document = types.StringValue(string(document))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading feature export data source", map[string]any{"success": true})
+}
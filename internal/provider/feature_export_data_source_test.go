@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeatureExportDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-export-checkout")
+			testAccCreateFeature(t, "default", "tf-export-search")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unleash_feature_export" "checkout" {
+						project     = "default"
+						environment = "development"
+						query       = "tf-export-checkout"
+					}
+
+					data "unleash_feature_export" "nothing" {
+						project     = "default"
+						environment = "development"
+						query       = "tf-export-does-not-exist"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_feature_export.checkout", "features.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_feature_export.checkout", "features.0", "tf-export-checkout"),
+					resource.TestCheckResourceAttrSet("data.unleash_feature_export.checkout", "document"),
+					resource.TestCheckResourceAttr("data.unleash_feature_export.nothing", "features.#", "0"),
+				),
+			},
+		},
+	})
+}
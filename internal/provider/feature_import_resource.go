@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &featureImportResource{}
+	_ resource.ResourceWithConfigure      = &featureImportResource{}
+	_ resource.ResourceWithValidateConfig = &featureImportResource{}
+	_ resource.ResourceWithModifyPlan     = &featureImportResource{}
+)
+
+func NewFeatureImportResource() resource.Resource {
+	return &featureImportResource{}
+}
+
+type featureImportResource struct {
+	client *unleash.APIClient
+}
+
+type featureImportResourceModel struct {
+	Project     types.String `tfsdk:"project"`
+	Environment types.String `tfsdk:"environment"`
+	Document    types.String `tfsdk:"document"`
+	Features    types.List   `tfsdk:"features"`
+}
+
+type featureImportApiModel struct {
+	Project     string          `json:"project"`
+	Environment string          `json:"environment"`
+	Data        json.RawMessage `json:"data"`
+}
+
+type featureImportValidationApiModel struct {
+	Errors      []featureImportValidationItemApiModel `json:"errors"`
+	Warnings    []featureImportValidationItemApiModel `json:"warnings"`
+	Permissions []featureImportValidationItemApiModel `json:"permissions"`
+}
+
+type featureImportValidationItemApiModel struct {
+	Message       string   `json:"message"`
+	AffectedItems []string `json:"affectedItems"`
+}
+
+func (i featureImportValidationItemApiModel) String() string {
+	if len(i.AffectedItems) == 0 {
+		return i.Message
+	}
+	return fmt.Sprintf("%s Affected: %s.", i.Message, strings.Join(i.AffectedItems, ", "))
+}
+
+func (r *featureImportResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *featureImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_import"
+}
+
+func (r *featureImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports a feature export, like the `document` of the `unleash_feature_export` data source, into a project and environment. The document is validated while planning: validation errors and missing permissions fail the plan and warnings are shown as warnings. Changing the document imports it again. Destroying the resource leaves the imported features in place. The import can't go through change requests, so planning fails when the environment has them enabled.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project to import the features into.",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "The environment to import the strategies and enabled state into.",
+				Required:    true,
+			},
+			"document": schema.StringAttribute{
+				Description: "The export to import, as a JSON document.",
+				Required:    true,
+			},
+			"features": schema.ListAttribute{
+				Description: "The names of the imported features.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *featureImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config featureImportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Document.IsUnknown() || config.Document.IsNull() {
+		return
+	}
+
+	if _, err := parseFeatureExport(config.Document.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("document"), "Invalid feature export", err.Error())
+	}
+}
+
+// ModifyPlan validates the document against the target project and environment whenever the apply would import it, and
+// refuses environments with change requests enabled.
+func (r *featureImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan featureImportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Project.IsUnknown() || plan.Environment.IsUnknown() || plan.Document.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state featureImportResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.Project.Equal(plan.Project) && state.Environment.Equal(plan.Environment) && state.Document.Equal(plan.Document) {
+			return
+		}
+	}
+
+	features, ok := importedFeatures(ctx, plan.Document.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("features"), features)...)
+
+	protected, ok := changeRequestEnvironments(ctx, r.client, plan.Project.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if slices.Contains(protected, plan.Environment.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("environment"),
+			"Change requests are enabled",
+			fmt.Sprintf("Project %s requires change requests in %s. The import can't go through change requests, so import into another environment and promote the changes in Unleash.", plan.Project.ValueString(), plan.Environment.ValueString()),
+		)
+		return
+	}
+
+	if !r.validate(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+
+	// strategy promotions planned after the import promote what it writes
+	var names []string
+	resp.Diagnostics.Append(features.ElementsAs(ctx, &names, false)...)
+	for _, name := range names {
+		planStrategyChanges(r.client, plan.Project.ValueString(), name, plan.Environment.ValueString())
+	}
+}
+
+func (r *featureImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create feature import resource")
+	var plan featureImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.importDocument(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating feature import resource", map[string]any{"success": true})
+}
+
+// Read keeps the state as it is. The import is a one-off write, and the imported features are free to change afterwards.
+func (r *featureImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature import resource")
+	var state featureImportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading feature import resource", map[string]any{"success": true})
+}
+
+func (r *featureImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update feature import resource")
+	var plan featureImportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.importDocument(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating feature import resource", map[string]any{"success": true})
+}
+
+func (r *featureImportResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete feature import resource")
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting feature import resource", map[string]any{"success": true})
+}
+
+// importDocument validates the document once more, since the target may have changed since plan, and imports it.
+func (r *featureImportResource) importDocument(ctx context.Context, model *featureImportResourceModel, diagnostics *diag.Diagnostics) bool {
+	features, ok := importedFeatures(ctx, model.Document.ValueString(), diagnostics)
+	if !ok {
+		return false
+	}
+
+	if !r.validate(ctx, *model, diagnostics) {
+		return false
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/features-batch/import", model.toApi(), nil)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return false
+	}
+
+	model.Features = features
+	return true
+}
+
+// validate reports the server's validation result as diagnostics and returns false when the import would fail.
+func (r *featureImportResource) validate(ctx context.Context, model featureImportResourceModel, diagnostics *diag.Diagnostics) bool {
+	var validation featureImportValidationApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/features-batch/validate", model.toApi(), &validation)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return false
+	}
+
+	for _, item := range validation.Errors {
+		diagnostics.AddAttributeError(path.Root("document"), "Feature import is invalid", item.String())
+	}
+	for _, item := range validation.Permissions {
+		diagnostics.AddAttributeError(path.Root("document"), "Missing permissions for feature import", item.String())
+	}
+	for _, item := range validation.Warnings {
+		diagnostics.AddAttributeWarning(path.Root("document"), "Feature import warning", item.String())
+	}
+
+	return len(validation.Errors) == 0 && len(validation.Permissions) == 0
+}
+
+func (m featureImportResourceModel) toApi() featureImportApiModel {
+	return featureImportApiModel{
+		Project:     m.Project.ValueString(),
+		Environment: m.Environment.ValueString(),
+		Data:        json.RawMessage(m.Document.ValueString()),
+	}
+}
+
+// parseFeatureExport checks that document is an export and returns it.
+func parseFeatureExport(document string) (featureExportApiModel, error) {
+	var export featureExportApiModel
+	if err := json.Unmarshal([]byte(document), &export); err != nil {
+		return export, fmt.Errorf("the document is not valid JSON: %w", err)
+	}
+	// an empty list decodes to an empty slice, so nil means the list is missing
+	if export.Features == nil {
+		return export, fmt.Errorf("the document has no features list")
+	}
+
+	return export, nil
+}
+
+func importedFeatures(ctx context.Context, document string, diagnostics *diag.Diagnostics) (types.List, bool) {
+	export, err := parseFeatureExport(document)
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("document"), "Invalid feature export", err.Error())
+		return types.ListNull(types.StringType), false
+	}
+
+	names := make([]string, 0, len(export.Features))
+	for _, feature := range export.Features {
+		names = append(names, feature.Name)
+	}
+
+	features, diags := types.ListValueFrom(ctx, types.StringType, names)
+	diagnostics.Append(diags...)
+	return features, !diags.HasError()
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeatureImportResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-import-checkout")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// the imported feature stays in place after destroy
+		CheckDestroy: testAccCheckFeatureEnabled(t, "default", "tf-import-checkout", "development", false),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unleash_feature_export" "checkout" {
+						project     = "default"
+						environment = "development"
+						query       = "tf-import-checkout"
+					}
+
+					resource "unleash_feature_import" "checkout" {
+						project     = "default"
+						environment = "development"
+						document    = data.unleash_feature_export.checkout.document
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_import.checkout", "features.#", "1"),
+					resource.TestCheckResourceAttr("unleash_feature_import.checkout", "features.0", "tf-import-checkout"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_feature_import" "invalid" {
+						project     = "default"
+						environment = "development"
+						document    = jsonencode({ featureStrategies = [] })
+					}
+				`,
+				ExpectError: regexp.MustCompile("the document has no features list"),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFeatureExport(t *testing.T) {
+	export, err := parseFeatureExport(`{"features":[{"name":"checkout","project":"default"},{"name":"search"}],"featureStrategies":[]}`)
+	require.NoError(t, err)
+	require.Len(t, export.Features, 2)
+	assert.Equal(t, "checkout", export.Features[0].Name)
+	assert.Equal(t, "search", export.Features[1].Name)
+
+	export, err = parseFeatureExport(emptyFeatureExport)
+	require.NoError(t, err)
+	assert.Empty(t, export.Features)
+
+	_, err = parseFeatureExport(`{"featureStrategies":[]}`)
+	assert.ErrorContains(t, err, "no features list")
+
+	_, err = parseFeatureExport(`not json`)
+	assert.ErrorContains(t, err, "not valid JSON")
+}
+
+func TestSearchFeaturesPages(t *testing.T) {
+	total := featureSearchPageSize + 20
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/admin/search/features", r.URL.Path)
+		assert.Equal(t, "IS:default", r.URL.Query().Get("project"))
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		features := []featureSearchApiModel{}
+		for i := offset; i < total && i < offset+featureSearchPageSize; i++ {
+			features = append(features, featureSearchApiModel{Name: fmt.Sprintf("feature-%d", i), Project: "default"})
+		}
+		_ = json.NewEncoder(w).Encode(featureSearchResultApiModel{Features: features, Total: int64(total)})
+	})
+
+	var diags diag.Diagnostics
+	features, ok := searchFeatures(context.Background(), client, url.Values{"project": {"IS:default"}}, &diags)
+	require.True(t, ok)
+	require.False(t, diags.HasError())
+	require.Len(t, features, total)
+	assert.Equal(t, "feature-0", features[0].Name)
+	assert.Equal(t, fmt.Sprintf("feature-%d", total-1), features[total-1].Name)
+}
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &featureLinkResource{}
+	_ resource.ResourceWithConfigure   = &featureLinkResource{}
+	_ resource.ResourceWithImportState = &featureLinkResource{}
+	_ resource.ResourceWithModifyPlan  = &featureLinkResource{}
+)
+
+func NewFeatureLinkResource() resource.Resource {
+	return &featureLinkResource{}
+}
+
+type featureLinkResource struct {
+	client *unleash.APIClient
+}
+
+type featureLinkResourceModel struct {
+	Id            types.String       `tfsdk:"id"`
+	Project       types.String       `tfsdk:"project"`
+	Feature       types.String       `tfsdk:"feature"`
+	Url           types.String       `tfsdk:"url"`
+	Title         types.String       `tfsdk:"title"`
+	FromTemplate  types.Bool         `tfsdk:"from_template"`
+	TemplateLinks []featureLinkModel `tfsdk:"template_links"`
+}
+
+type featureLinkModel struct {
+	Url   types.String `tfsdk:"url"`
+	Title types.String `tfsdk:"title"`
+}
+
+type projectLinkTemplatesApiModel struct {
+	LinkTemplates []projectLinkTemplateApiModel `json:"linkTemplates"`
+}
+
+type projectLinkTemplateApiModel struct {
+	Title       *string `json:"title"`
+	UrlTemplate string  `json:"urlTemplate"`
+}
+
+func (r *featureLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *featureLinkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_link"
+}
+
+func (r *featureLinkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an external link on a feature flag. Links created by project link templates are adopted rather than duplicated, and are left in place on destroy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the link.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "The project the feature flag belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"feature": schema.StringAttribute{
+				Description: "The name of the feature flag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL the feature flag links to.",
+				Required:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "The title shown for the link in the Unleash UI.",
+				Optional:    true,
+			},
+			"from_template": schema.BoolAttribute{
+				Description: "Whether the link matches one of the project's link templates, meaning Unleash generated it when the feature flag was created.",
+				Computed:    true,
+			},
+			"template_links": schema.ListNestedAttribute{
+				Description: "The other links on the feature flag that were generated by the project's link templates. These are left to the templates and aren't managed by this resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "The URL of the link.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the link.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *featureLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *featureLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import feature link resource")
+
+	// The unique identifier for a feature link is: "<project>:<feature>:<link id>"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format '<project>:<feature>:<link id>'. Example: 'default:new-checkout:01JTJNCJ5XVP2KPJFA03YRBZCA'",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+
+	tflog.Debug(ctx, "Finished importing feature link resource", map[string]any{"success": true})
+}
+
+func (r *featureLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create feature link resource")
+	var plan featureLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var feature featureApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featurePath(plan.Project.ValueString(), plan.Feature.ValueString()), nil, &feature)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	// adopt a link with the same URL instead of adding a duplicate, most likely one generated by a link template
+	if existing := findFeatureLink(feature.Links, func(link featureLinkApiModel) bool { return link.Url == plan.Url.ValueString() }); existing != nil {
+		plan.Id = types.StringValue(existing.Id)
+		if !r.update(ctx, plan, &resp.Diagnostics) {
+			return
+		}
+	} else {
+		existingIds := make(map[string]bool, len(feature.Links))
+		for _, link := range feature.Links {
+			existingIds[link.Id] = true
+		}
+
+		httpRes, err = adminApiRequest(ctx, r.client, http.MethodPost, featureLinksPath(plan.Project.ValueString(), plan.Feature.ValueString()), plan.toApi(), nil)
+		if !ValidateApiResponse(httpRes, 204, &resp.Diagnostics, err) {
+			return
+		}
+
+		// the create endpoint doesn't return the link, so look for the one that wasn't there before
+		httpRes, err = adminApiRequest(ctx, r.client, http.MethodGet, featurePath(plan.Project.ValueString(), plan.Feature.ValueString()), nil, &feature)
+		if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+
+		created := findFeatureLink(feature.Links, func(link featureLinkApiModel) bool {
+			return !existingIds[link.Id] && link.Url == plan.Url.ValueString()
+		})
+		if created == nil {
+			resp.Diagnostics.AddError(
+				"Unable to find created feature link",
+				fmt.Sprintf("The link to %s was added to feature %s, but it could not be found afterwards.", plan.Url.ValueString(), plan.Feature.ValueString()),
+			)
+			return
+		}
+		plan.Id = types.StringValue(created.Id)
+	}
+
+	if !r.refreshTemplateState(ctx, &plan, feature.Links, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating feature link resource", map[string]any{"success": true})
+}
+
+func (r *featureLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature link resource")
+	var state featureLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var feature featureApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featurePath(state.Project.ValueString(), state.Feature.ValueString()), nil, &feature)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Feature.ValueString(), "Feature") {
+		return
+	}
+
+	link := findFeatureLink(feature.Links, func(link featureLinkApiModel) bool { return link.Id == state.Id.ValueString() })
+	if link == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Link %s no longer exists on feature %s, removing from state", state.Id.ValueString(), state.Feature.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.hydrateFromApi(*link)
+	if !r.refreshTemplateState(ctx, &state, feature.Links, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading feature link resource", map[string]any{"success": true})
+}
+
+func (r *featureLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update feature link resource")
+	var plan featureLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.update(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+
+	var feature featureApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featurePath(plan.Project.ValueString(), plan.Feature.ValueString()), nil, &feature)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	if !r.refreshTemplateState(ctx, &plan, feature.Links, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating feature link resource", map[string]any{"success": true})
+}
+
+func (r *featureLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete feature link resource")
+	var state featureLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.FromTemplate.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("Link %s on feature %s was generated by a project link template, leaving it in place", state.Id.ValueString(), state.Feature.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, featureLinkPath(state.Project.ValueString(), state.Feature.ValueString(), state.Id.ValueString()), nil, nil)
+	if !isNotFoundResponse(httpRes) && !ValidateApiResponse(httpRes, 204, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting feature link resource", map[string]any{"success": true})
+}
+
+func (r *featureLinkResource) update(ctx context.Context, plan featureLinkResourceModel, diagnostics *diag.Diagnostics) bool {
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, featureLinkPath(plan.Project.ValueString(), plan.Feature.ValueString(), plan.Id.ValueString()), plan.toApi(), nil)
+	return ValidateApiResponse(httpRes, 204, diagnostics, err)
+}
+
+// refreshTemplateState works out which links on the feature come from the project's link templates.
+func (r *featureLinkResource) refreshTemplateState(ctx context.Context, model *featureLinkResourceModel, links []featureLinkApiModel, diagnostics *diag.Diagnostics) bool {
+	var project projectLinkTemplatesApiModel
+	projectPath := "/api/admin/projects/" + url.PathEscape(model.Project.ValueString()) + "/overview"
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, projectPath, nil, &project)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return false
+	}
+
+	model.FromTemplate = types.BoolValue(false)
+	model.TemplateLinks = []featureLinkModel{}
+	for _, link := range links {
+		if !matchesLinkTemplate(link, project.LinkTemplates, model.Project.ValueString(), model.Feature.ValueString()) {
+			continue
+		}
+
+		if link.Id == model.Id.ValueString() {
+			model.FromTemplate = types.BoolValue(true)
+			continue
+		}
+
+		model.TemplateLinks = append(model.TemplateLinks, featureLinkModel{
+			Url:   types.StringValue(link.Url),
+			Title: types.StringPointerValue(link.Title),
+		})
+	}
+
+	return true
+}
+
+func featureLinksPath(project string, feature string) string {
+	return featurePath(project, feature) + "/link"
+}
+
+func featureLinkPath(project string, feature string, id string) string {
+	return featureLinksPath(project, feature) + "/" + url.PathEscape(id)
+}
+
+func findFeatureLink(links []featureLinkApiModel, matches func(featureLinkApiModel) bool) *featureLinkApiModel {
+	for i := range links {
+		if matches(links[i]) {
+			return &links[i]
+		}
+	}
+	return nil
+}
+
+// matchesLinkTemplate reports whether a link is what one of the templates renders to for the given project and feature.
+func matchesLinkTemplate(link featureLinkApiModel, templates []projectLinkTemplateApiModel, project string, feature string) bool {
+	replacer := strings.NewReplacer("{{project}}", project, "{{feature}}", feature)
+	for _, template := range templates {
+		if replacer.Replace(template.UrlTemplate) == link.Url {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *featureLinkResourceModel) toApi() featureLinkApiModel {
+	return featureLinkApiModel{
+		Url:   m.Url.ValueString(),
+		Title: m.Title.ValueStringPointer(),
+	}
+}
+
+func (m *featureLinkResourceModel) hydrateFromApi(link featureLinkApiModel) {
+	m.Id = types.StringValue(link.Id)
+	m.Url = types.StringValue(link.Url)
+
+	if link.Title != nil && *link.Title != "" {
+		m.Title = types.StringValue(*link.Title)
+	} else {
+		m.Title = types.StringNull()
+	}
+}
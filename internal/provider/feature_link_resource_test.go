@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccFeatureLinkResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-linked-feature")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_feature_link" "ticket" {
+						project = "default"
+						feature = "tf-linked-feature"
+						url     = "https://jira.example.com/browse/CHK-1"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_feature_link.ticket", "id"),
+					resource.TestCheckResourceAttr("unleash_feature_link.ticket", "url", "https://jira.example.com/browse/CHK-1"),
+					resource.TestCheckNoResourceAttr("unleash_feature_link.ticket", "title"),
+					resource.TestCheckResourceAttr("unleash_feature_link.ticket", "from_template", "false"),
+					resource.TestCheckResourceAttr("unleash_feature_link.ticket", "template_links.#", "0"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_feature_link" "ticket" {
+						project = "default"
+						feature = "tf-linked-feature"
+						url     = "https://jira.example.com/browse/CHK-2"
+						title   = "Jira ticket"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_link.ticket", "url", "https://jira.example.com/browse/CHK-2"),
+					resource.TestCheckResourceAttr("unleash_feature_link.ticket", "title", "Jira ticket"),
+				),
+			},
+			{
+				ResourceName: "unleash_feature_link.ticket",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["unleash_feature_link.ticket"]
+					if !ok {
+						return "", fmt.Errorf("Resource not found: unleash_feature_link.ticket")
+					}
+					return "default:tf-linked-feature:" + rs.Primary.Attributes["id"], nil
+				},
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesLinkTemplate(t *testing.T) {
+	title := "Dashboard"
+	templates := []projectLinkTemplateApiModel{
+		{Title: &title, UrlTemplate: "https://grafana.example.com/d/{{project}}?flag={{feature}}"},
+		{UrlTemplate: "https://github.com/search?q={{feature}}"},
+	}
+
+	assert.True(t, matchesLinkTemplate(featureLinkApiModel{Url: "https://grafana.example.com/d/default?flag=new-checkout", Title: &title}, templates, "default", "new-checkout"))
+	assert.True(t, matchesLinkTemplate(featureLinkApiModel{Url: "https://github.com/search?q=new-checkout"}, templates, "default", "new-checkout"))
+	assert.False(t, matchesLinkTemplate(featureLinkApiModel{Url: "https://github.com/search?q=other-flag"}, templates, "default", "new-checkout"))
+	assert.False(t, matchesLinkTemplate(featureLinkApiModel{Url: "https://jira.example.com/CHK-1"}, templates, "default", "new-checkout"))
+	assert.False(t, matchesLinkTemplate(featureLinkApiModel{Url: "https://github.com/search?q=new-checkout"}, nil, "default", "new-checkout"))
+}
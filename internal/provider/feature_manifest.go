@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Feature manifests describe the features of a project in a YAML or JSON document. Field names follow the Unleash API,
+// so strategies can be copied from an export or the admin UI.
+type featureManifestDocument struct {
+	Features map[string]manifestFeature `yaml:"features"`
+}
+
+type manifestFeature struct {
+	Type           string                         `yaml:"type" json:"type"`
+	Description    string                         `yaml:"description" json:"description,omitempty"`
+	ImpressionData bool                           `yaml:"impressionData" json:"impressionData,omitempty"`
+	Tags           []tagApiModel                  `yaml:"tags" json:"tags,omitempty"`
+	Environments   map[string]manifestEnvironment `yaml:"environments" json:"environments,omitempty"`
+}
+
+type manifestEnvironment struct {
+	Enabled    bool               `yaml:"enabled" json:"enabled"`
+	Strategies []manifestStrategy `yaml:"strategies" json:"strategies,omitempty"`
+}
+
+type manifestStrategy struct {
+	Name        string               `yaml:"name" json:"name"`
+	Title       string               `yaml:"title" json:"title,omitempty"`
+	Disabled    bool                 `yaml:"disabled" json:"disabled,omitempty"`
+	Parameters  map[string]string    `yaml:"parameters" json:"parameters,omitempty"`
+	Constraints []manifestConstraint `yaml:"constraints" json:"constraints,omitempty"`
+	Variants    []manifestVariant    `yaml:"variants" json:"variants,omitempty"`
+	Segments    []int64              `yaml:"segments" json:"segments,omitempty"`
+}
+
+type manifestConstraint struct {
+	ContextName     string   `yaml:"contextName" json:"contextName"`
+	Operator        string   `yaml:"operator" json:"operator"`
+	Values          []string `yaml:"values" json:"values,omitempty"`
+	Value           string   `yaml:"value" json:"value,omitempty"`
+	Inverted        bool     `yaml:"inverted" json:"inverted,omitempty"`
+	CaseInsensitive bool     `yaml:"caseInsensitive" json:"caseInsensitive,omitempty"`
+}
+
+type manifestVariant struct {
+	Name       string                  `yaml:"name" json:"name"`
+	Weight     int64                   `yaml:"weight" json:"weight,omitempty"`
+	WeightType string                  `yaml:"weightType" json:"weightType"`
+	Stickiness string                  `yaml:"stickiness" json:"stickiness"`
+	Payload    *variantPayloadApiModel `yaml:"payload" json:"payload,omitempty"`
+}
+
+// parseFeatureManifest reads a manifest document and returns its features in their canonical form. YAML is a superset
+// of JSON, so both are read the same way.
+func parseFeatureManifest(document string) (map[string]manifestFeature, error) {
+	decoder := yaml.NewDecoder(bytes.NewBufferString(document))
+	decoder.KnownFields(true)
+
+	var manifest featureManifestDocument
+	if err := decoder.Decode(&manifest); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("the document is not a valid feature manifest: %w", err)
+	}
+
+	features := make(map[string]manifestFeature, len(manifest.Features))
+	for name, feature := range manifest.Features {
+		if name == "" {
+			return nil, fmt.Errorf("the document has a feature without a name")
+		}
+		for environment, featureEnvironment := range feature.Environments {
+			// enabling an environment without strategies makes Unleash add the project's default strategy
+			if featureEnvironment.Enabled && len(featureEnvironment.Strategies) == 0 {
+				return nil, fmt.Errorf("feature %s is enabled in %s without strategies, list the strategies it should have, for example `- name: default`", name, environment)
+			}
+			for i, strategy := range featureEnvironment.Strategies {
+				if strategy.Name == "" {
+					return nil, fmt.Errorf("strategy %d of feature %s in %s has no name", i+1, name, environment)
+				}
+			}
+		}
+		features[name] = feature.normalize()
+	}
+
+	return features, nil
+}
+
+// normalize fills in the defaults Unleash applies, so a feature read from the API and the same feature written in a
+// manifest are equal.
+func (f manifestFeature) normalize() manifestFeature {
+	if f.Type == "" {
+		f.Type = "release"
+	}
+
+	if len(f.Tags) == 0 {
+		f.Tags = nil
+	} else {
+		f.Tags = slices.SortedFunc(slices.Values(f.Tags), func(a, b tagApiModel) int {
+			return cmp.Or(cmp.Compare(a.Type, b.Type), cmp.Compare(a.Value, b.Value))
+		})
+		f.Tags = slices.Compact(f.Tags)
+	}
+
+	if len(f.Environments) == 0 {
+		f.Environments = nil
+		return f
+	}
+
+	environments := make(map[string]manifestEnvironment, len(f.Environments))
+	for name, environment := range f.Environments {
+		strategies := make([]manifestStrategy, 0, len(environment.Strategies))
+		for _, strategy := range environment.Strategies {
+			strategies = append(strategies, strategy.normalize())
+		}
+		if len(strategies) == 0 {
+			strategies = nil
+		}
+		environments[name] = manifestEnvironment{Enabled: environment.Enabled, Strategies: strategies}
+	}
+	f.Environments = environments
+
+	return f
+}
+
+func (s manifestStrategy) normalize() manifestStrategy {
+	if len(s.Parameters) == 0 {
+		s.Parameters = nil
+	}
+	if len(s.Constraints) == 0 {
+		s.Constraints = nil
+	}
+	for i, constraint := range s.Constraints {
+		if len(constraint.Values) == 0 {
+			constraint.Values = nil
+		}
+		s.Constraints[i] = constraint
+	}
+
+	if len(s.Variants) == 0 {
+		s.Variants = nil
+	}
+	for i, variant := range s.Variants {
+		if variant.WeightType == "" {
+			variant.WeightType = "variable"
+		}
+		if variant.Stickiness == "" {
+			variant.Stickiness = "default"
+		}
+		// Unleash works out variable weights itself, so only fixed weights are part of the manifest
+		if variant.WeightType == "variable" {
+			variant.Weight = 0
+		}
+		s.Variants[i] = variant
+	}
+
+	if len(s.Segments) == 0 {
+		s.Segments = nil
+	} else {
+		s.Segments = slices.Sorted(slices.Values(s.Segments))
+	}
+
+	return s
+}
+
+func (s manifestStrategy) toApi(sortOrder int64) featureStrategyApiModel {
+	strategy := featureStrategyApiModel{
+		Name:        s.Name,
+		SortOrder:   &sortOrder,
+		Parameters:  map[string]string{},
+		Constraints: []constraintApiModel{},
+		Variants:    []strategyVariantApiModel{},
+		Segments:    []int64{},
+	}
+
+	if s.Title != "" {
+		strategy.Title = &s.Title
+	}
+	if s.Disabled {
+		strategy.Disabled = &s.Disabled
+	}
+	maps.Copy(strategy.Parameters, s.Parameters)
+	for _, constraint := range s.Constraints {
+		apiConstraint := constraintApiModel{
+			ContextName:     constraint.ContextName,
+			Operator:        constraint.Operator,
+			Values:          constraint.Values,
+			Inverted:        constraint.Inverted,
+			CaseInsensitive: constraint.CaseInsensitive,
+		}
+		if apiConstraint.Values == nil {
+			apiConstraint.Values = []string{}
+		}
+		if constraint.Value != "" {
+			apiConstraint.Value = &constraint.Value
+		}
+		strategy.Constraints = append(strategy.Constraints, apiConstraint)
+	}
+	for _, variant := range s.Variants {
+		strategy.Variants = append(strategy.Variants, strategyVariantApiModel{
+			Name:       variant.Name,
+			Weight:     variant.Weight,
+			WeightType: variant.WeightType,
+			Stickiness: variant.Stickiness,
+			Payload:    variant.Payload,
+		})
+	}
+	strategy.Segments = append(strategy.Segments, s.Segments...)
+
+	return strategy
+}
+
+func manifestStrategyFromApi(strategy featureStrategyApiModel) manifestStrategy {
+	model := manifestStrategy{
+		Name:       strategy.Name,
+		Parameters: strategy.Parameters,
+		Segments:   strategy.Segments,
+	}
+
+	if strategy.Title != nil {
+		model.Title = *strategy.Title
+	}
+	if strategy.Disabled != nil {
+		model.Disabled = *strategy.Disabled
+	}
+	for _, constraint := range strategy.Constraints {
+		manifestConstraint := manifestConstraint{
+			ContextName:     constraint.ContextName,
+			Operator:        constraint.Operator,
+			Values:          constraint.Values,
+			Inverted:        constraint.Inverted,
+			CaseInsensitive: constraint.CaseInsensitive,
+		}
+		if constraint.Value != nil {
+			manifestConstraint.Value = *constraint.Value
+		}
+		model.Constraints = append(model.Constraints, manifestConstraint)
+	}
+	for _, variant := range strategy.Variants {
+		model.Variants = append(model.Variants, manifestVariant{
+			Name:       variant.Name,
+			Weight:     variant.Weight,
+			WeightType: variant.WeightType,
+			Stickiness: variant.Stickiness,
+			Payload:    variant.Payload,
+		})
+	}
+
+	return model.normalize()
+}
+
+// manifestFeatureFromApi describes a feature the way a manifest would. For a feature the manifest manages, only the
+// environments it lists are included, and strategy parameters it doesn't set are left out, since Unleash fills in
+// defaults such as the groupId and stickiness of a gradual rollout. Otherwise, the environments the feature is enabled
+// in or has strategies in are included with every parameter.
+func manifestFeatureFromApi(feature featureApiModel, tags []tagApiModel, managed *manifestFeature) manifestFeature {
+	model := manifestFeature{
+		Type:           feature.Type,
+		ImpressionData: feature.ImpressionData,
+		Tags:           tags,
+		Environments:   map[string]manifestEnvironment{},
+	}
+
+	if feature.Description != nil {
+		model.Description = *feature.Description
+	}
+
+	for _, environment := range feature.Environments {
+		if managed != nil && !slices.Contains(managed.environmentNames(), environment.Name) {
+			continue
+		}
+		if managed == nil && !environment.Enabled && len(environment.Strategies) == 0 {
+			continue
+		}
+
+		strategies := make([]manifestStrategy, 0, len(environment.Strategies))
+		for i, apiStrategy := range sortedFeatureStrategies(environment.Strategies) {
+			strategy := manifestStrategyFromApi(apiStrategy)
+			if managed != nil {
+				configured := managed.Environments[environment.Name].Strategies
+				if i < len(configured) && configured[i].Name == strategy.Name {
+					strategy.Parameters = configuredParameters(strategy.Parameters, configured[i].Parameters)
+				}
+			}
+			strategies = append(strategies, strategy)
+		}
+		model.Environments[environment.Name] = manifestEnvironment{Enabled: environment.Enabled, Strategies: strategies}
+	}
+
+	return model.normalize()
+}
+
+// configuredParameters keeps the parameters a manifest sets, dropping the ones Unleash added.
+func configuredParameters(parameters map[string]string, configured map[string]string) map[string]string {
+	kept := map[string]string{}
+	for name, value := range parameters {
+		if _, ok := configured[name]; ok {
+			kept[name] = value
+		}
+	}
+	if len(kept) == 0 {
+		return nil
+	}
+	return kept
+}
+
+func sortedFeatureStrategies(strategies []featureStrategyApiModel) []featureStrategyApiModel {
+	return slices.SortedStableFunc(slices.Values(strategies), func(a, b featureStrategyApiModel) int {
+		var aOrder, bOrder int64
+		if a.SortOrder != nil {
+			aOrder = *a.SortOrder
+		}
+		if b.SortOrder != nil {
+			bOrder = *b.SortOrder
+		}
+		return cmp.Compare(aOrder, bOrder)
+	})
+}
+
+// canonicalJSON is how a feature is kept in state. Struct fields and map keys are always written in the same order, so
+// equal features have equal JSON and Terraform can show a structured diff of what changes.
+func (f manifestFeature) canonicalJSON() string {
+	document, _ := json.Marshal(f)
+	return string(document)
+}
+
+// environmentNames returns the environments the manifest manages for the feature, which is never nil.
+func (f manifestFeature) environmentNames() []string {
+	return append([]string{}, slices.Sorted(maps.Keys(f.Environments))...)
+}
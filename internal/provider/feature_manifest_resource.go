@@ -0,0 +1,641 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math/big"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &featureManifestResource{}
+	_ resource.ResourceWithConfigure      = &featureManifestResource{}
+	_ resource.ResourceWithImportState    = &featureManifestResource{}
+	_ resource.ResourceWithValidateConfig = &featureManifestResource{}
+	_ resource.ResourceWithModifyPlan     = &featureManifestResource{}
+)
+
+func NewFeatureManifestResource() resource.Resource {
+	return &featureManifestResource{}
+}
+
+type featureManifestResource struct {
+	client *unleash.APIClient
+}
+
+type featureManifestResourceModel struct {
+	Project          types.String  `tfsdk:"project"`
+	Document         types.Dynamic `tfsdk:"document"`
+	ArchiveUnmanaged types.Bool    `tfsdk:"archive_unmanaged"`
+	Features         types.Map     `tfsdk:"features"`
+}
+
+func (r *featureManifestResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *featureManifestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_manifest"
+}
+
+func (r *featureManifestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages every feature flag of a project from a single YAML or JSON document, with their type, description, impression data, tags, and enabled state and strategies per environment. " +
+			"The manifest is authoritative for the features it lists: tags and strategies added outside of Terraform are removed, and features removed from the document are archived. Only the environments listed for a feature are managed. " +
+			"Features are reconciled in parallel, within the provider's `max_concurrent_requests` limit. Destroying the resource archives the features it manages. " +
+			"The manifest writes straight to the API and can't go through change requests, so planning fails when it manages an environment that has them enabled.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project whose features are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"document": schema.DynamicAttribute{
+				Description: "The manifest, either as an object like `yamldecode(file(\"flags.yaml\"))` or `{ features = { ... } }`, or as a YAML or JSON string like `file(\"flags.yaml\")`. " +
+					"It has a `features` map keyed by feature name. Every feature can set `type` (defaults to `release`), `description`, `impressionData`, `tags` (a list of `type` and `value`) and `environments`, " +
+					"a map keyed by environment name with `enabled` and a list of `strategies` written the way the Unleash API writes them. " +
+					"An enabled environment needs at least one strategy. Strategy parameters the document doesn't set, such as the `groupId` Unleash gives a gradual rollout, are left to Unleash.",
+				Required: true,
+			},
+			"archive_unmanaged": schema.BoolAttribute{
+				Description: "Whether to also archive features in the project that the document never listed, such as features created in the Unleash UI. Defaults to false, which leaves them alone.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"features": schema.MapAttribute{
+				Description: "Every feature in the project as JSON, keyed by feature name. While planning this shows what the apply will create, change and archive. Features the document doesn't list are read with every environment that has something in it.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *featureManifestResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config featureManifestResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isManifestDocumentKnown(ctx, config.Document) || config.Document.IsNull() {
+		return
+	}
+
+	if _, err := parseManifestDocument(ctx, config.Document); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("document"), "Invalid feature manifest", err.Error())
+	}
+}
+
+// ModifyPlan plans the features the manifest describes, so Terraform shows a diff per feature against what Read found.
+// It also records the environments whose strategies change for strategy promotions planned later.
+func (r *featureManifestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan featureManifestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !isManifestDocumentKnown(ctx, plan.Document) {
+		return
+	}
+
+	desired, err := parseManifestDocument(ctx, plan.Document)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("document"), "Invalid feature manifest", err.Error())
+		return
+	}
+
+	var state featureManifestResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	planned := desired
+	if !plan.ArchiveUnmanaged.ValueBool() && !req.State.Raw.IsNull() {
+		planned = withUnmanagedFeatures(desired, manifestDocumentFeatures(ctx, state.Document), decodeManifestFeatures(ctx, state.Features, &resp.Diagnostics))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	features := manifestFeaturesMap(ctx, planned, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("features"), features)...)
+
+	if r.client != nil && !plan.Project.IsUnknown() && !features.Equal(state.Features) {
+		r.refuseChangeRequestEnvironments(ctx, plan.Project.ValueString(), desired, &resp.Diagnostics)
+
+		// strategy promotions planned after the manifest promote what it writes
+		var current map[string]manifestFeature
+		if !req.State.Raw.IsNull() {
+			current = decodeManifestFeatures(ctx, state.Features, &resp.Diagnostics)
+		}
+		for name, feature := range desired {
+			for _, environment := range feature.environmentNames() {
+				existing, ok := current[name]
+				if !ok || !reflect.DeepEqual(existing.Environments[environment].Strategies, feature.Environments[environment].Strategies) {
+					planStrategyChanges(r.client, plan.Project.ValueString(), name, environment)
+				}
+			}
+		}
+	}
+}
+
+// refuseChangeRequestEnvironments fails the plan when the manifest manages environments that have change requests
+// enabled, since its writes can't go through them.
+func (r *featureManifestResource) refuseChangeRequestEnvironments(ctx context.Context, project string, desired map[string]manifestFeature, diagnostics *diag.Diagnostics) {
+	protected, ok := changeRequestEnvironments(ctx, r.client, project, diagnostics)
+	if !ok || len(protected) == 0 {
+		return
+	}
+
+	var environments []string
+	for _, feature := range desired {
+		for _, environment := range feature.environmentNames() {
+			if slices.Contains(protected, environment) && !slices.Contains(environments, environment) {
+				environments = append(environments, environment)
+			}
+		}
+	}
+	if len(environments) == 0 {
+		return
+	}
+
+	slices.Sort(environments)
+	diagnostics.AddAttributeError(
+		path.Root("document"),
+		"Change requests are enabled",
+		fmt.Sprintf("Project %s requires change requests in %s. The manifest can't write through change requests, so remove these environments from the document and manage them in Unleash.", project, strings.Join(environments, ", ")),
+	)
+}
+
+func (r *featureManifestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import feature manifest resource")
+	resource.ImportStatePassthroughID(ctx, path.Root("project"), req, resp)
+	tflog.Debug(ctx, "Finished importing feature manifest resource", map[string]any{"success": true})
+}
+
+func (r *featureManifestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create feature manifest resource")
+	var plan featureManifestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.reconcile(ctx, &plan, nil, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating feature manifest resource", map[string]any{"success": true})
+}
+
+func (r *featureManifestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature manifest resource")
+	var state featureManifestResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// after an import nothing is managed yet, so every environment with something in it is read
+	scope := manifestDocumentFeatures(ctx, state.Document)
+
+	current, httpRes, err := r.current(ctx, state.Project.ValueString(), scope, &resp.Diagnostics)
+	if err != nil || httpRes == nil || httpRes.StatusCode != http.StatusOK {
+		ValidateReadApiResponse(ctx, httpRes, err, resp, state.Project.ValueString(), "Project")
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Features = manifestFeaturesMap(ctx, current, &resp.Diagnostics)
+	if state.ArchiveUnmanaged.IsNull() {
+		state.ArchiveUnmanaged = types.BoolValue(false)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading feature manifest resource", map[string]any{"success": true})
+}
+
+func (r *featureManifestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update feature manifest resource")
+	var plan, state featureManifestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.reconcile(ctx, &plan, manifestDocumentFeatures(ctx, state.Document), &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating feature manifest resource", map[string]any{"success": true})
+}
+
+func (r *featureManifestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete feature manifest resource")
+	var state featureManifestResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := manifestDocumentFeatures(ctx, state.Document)
+	resp.Diagnostics.Append(eachFeature(slices.Sorted(maps.Keys(managed)), func(name string, diagnostics *diag.Diagnostics) {
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, featurePath(state.Project.ValueString(), name), nil, nil)
+		if isNotFoundResponse(httpRes) {
+			return
+		}
+		IsValidApiResponse(httpRes, []int{200, 202}, diagnostics, err)
+	})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting feature manifest resource", map[string]any{"success": true})
+}
+
+// reconcile makes the project match the manifest. Features that left the manifest since the previous apply are
+// archived, along with every other feature missing from it when archive_unmanaged is set. Every feature is reconciled
+// on its own, so one that fails doesn't stop the others.
+func (r *featureManifestResource) reconcile(ctx context.Context, plan *featureManifestResourceModel, previous map[string]manifestFeature, diagnostics *diag.Diagnostics) bool {
+	desired, err := parseManifestDocument(ctx, plan.Document)
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("document"), "Invalid feature manifest", err.Error())
+		return false
+	}
+
+	current, httpRes, err := r.current(ctx, plan.Project.ValueString(), desired, diagnostics)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) || diagnostics.HasError() {
+		return false
+	}
+
+	names := slices.Sorted(maps.Keys(desired))
+	for name := range current {
+		if _, ok := desired[name]; ok {
+			continue
+		}
+		if _, ok := previous[name]; ok || plan.ArchiveUnmanaged.ValueBool() {
+			names = append(names, name)
+		}
+	}
+
+	diagnostics.Append(eachFeature(names, func(name string, featureDiagnostics *diag.Diagnostics) {
+		var currentFeature, desiredFeature *manifestFeature
+		if feature, ok := current[name]; ok {
+			currentFeature = &feature
+		}
+		if feature, ok := desired[name]; ok {
+			desiredFeature = &feature
+		}
+		r.reconcileFeature(ctx, plan.Project.ValueString(), name, currentFeature, desiredFeature, featureDiagnostics)
+	})...)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	// features the plan left alone stay as they were planned
+	planned := desired
+	if !plan.Features.IsUnknown() && !plan.Features.IsNull() {
+		planned = withUnmanagedFeatures(desired, previous, decodeManifestFeatures(ctx, plan.Features, diagnostics))
+	}
+	plan.Features = manifestFeaturesMap(ctx, planned, diagnostics)
+	return !diagnostics.HasError()
+}
+
+// reconcileFeature creates, changes or archives a single feature. current is nil for a feature that doesn't exist yet,
+// desired is nil for a feature that isn't in the manifest.
+func (r *featureManifestResource) reconcileFeature(ctx context.Context, project string, name string, current *manifestFeature, desired *manifestFeature, diagnostics *diag.Diagnostics) {
+	if desired == nil {
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, featurePath(project, name), nil, nil)
+		if IsValidApiResponse(httpRes, []int{200, 202}, diagnostics, err) {
+			tflog.Info(ctx, fmt.Sprintf("Archived feature %s, it isn't in the manifest of project %s", name, project))
+		}
+		return
+	}
+
+	details := createFeatureApiModel{
+		Name:           name,
+		Type:           desired.Type,
+		Description:    &desired.Description,
+		ImpressionData: desired.ImpressionData,
+	}
+	if current == nil {
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, projectFeaturesPath(project), details, nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return
+		}
+		current = &manifestFeature{Type: desired.Type, Description: desired.Description, ImpressionData: desired.ImpressionData}
+	}
+
+	if current.Type != desired.Type || current.Description != desired.Description || current.ImpressionData != desired.ImpressionData {
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, featurePath(project, name), details, nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return
+		}
+	}
+
+	if update := diffTags(current.Tags, desired.Tags); len(update.AddedTags) > 0 || len(update.RemovedTags) > 0 {
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, featureTagsPath(name), update, nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return
+		}
+	}
+
+	for _, environment := range desired.environmentNames() {
+		wanted := desired.Environments[environment]
+		existing := current.Environments[environment]
+
+		// both sides are normalized, and current only has the strategy parameters the manifest sets
+		if !reflect.DeepEqual(existing.Strategies, wanted.Strategies) && !r.replaceStrategies(ctx, project, name, environment, wanted.Strategies, diagnostics) {
+			return
+		}
+
+		// strategies go first, so enabling a feature doesn't add the project's default strategy
+		if existing.Enabled != wanted.Enabled {
+			state := "off"
+			if wanted.Enabled {
+				state = "on"
+			}
+			httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, featureEnvironmentPath(project, name, environment)+"/"+state, nil, nil)
+			if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+				return
+			}
+		}
+	}
+}
+
+// replaceStrategies adds the manifest's strategies in order before removing the old ones, so the environment never
+// goes without strategies in between.
+func (r *featureManifestResource) replaceStrategies(ctx context.Context, project string, feature string, environment string, strategies []manifestStrategy, diagnostics *diag.Diagnostics) bool {
+	strategiesPath := featureEnvironmentPath(project, feature, environment) + "/strategies"
+
+	var existing []featureStrategyApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, strategiesPath, nil, &existing)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return false
+	}
+
+	for i, strategy := range strategies {
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, strategiesPath, strategy.toApi(int64(i)), nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return false
+		}
+	}
+
+	for _, strategy := range existing {
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, strategiesPath+"/"+url.PathEscape(strategy.Id), nil, nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// current reads every feature in the project the way a manifest would describe it. Features in scope are read with
+// the environments the manifest lists for them. The response of the project read is returned so callers can tell a
+// missing project apart from other errors.
+func (r *featureManifestResource) current(ctx context.Context, project string, scope map[string]manifestFeature, diagnostics *diag.Diagnostics) (map[string]manifestFeature, *http.Response, error) {
+	var features projectFeaturesApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, projectFeaturesPath(project), nil, &features)
+	if err != nil || httpRes == nil || httpRes.StatusCode != http.StatusOK {
+		return nil, httpRes, err
+	}
+
+	tags := make(map[string][]tagApiModel, len(features.Features))
+	names := make([]string, 0, len(features.Features))
+	for _, feature := range features.Features {
+		tags[feature.Name] = feature.Tags
+		names = append(names, feature.Name)
+	}
+
+	var lock sync.Mutex
+	current := make(map[string]manifestFeature, len(names))
+	diagnostics.Append(eachFeature(names, func(name string, featureDiagnostics *diag.Diagnostics) {
+		var feature featureApiModel
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featurePath(project, name), nil, &feature)
+		if !ValidateApiResponse(httpRes, 200, featureDiagnostics, err) {
+			return
+		}
+
+		var managed *manifestFeature
+		if scoped, ok := scope[name]; ok {
+			managed = &scoped
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+		current[name] = manifestFeatureFromApi(feature, tags[name], managed)
+	})...)
+
+	return current, httpRes, nil
+}
+
+// eachFeature calls fn for every feature at once. The provider's HTTP client caps the requests in flight, so this
+// keeps as many of them busy as max_concurrent_requests allows. Diagnostics come back in the order of names.
+func eachFeature(names []string, fn func(name string, diagnostics *diag.Diagnostics)) diag.Diagnostics {
+	results := make([]diag.Diagnostics, len(names))
+
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fn(name, &results[i])
+		}()
+	}
+	wg.Wait()
+
+	var diagnostics diag.Diagnostics
+	for _, result := range results {
+		diagnostics.Append(result...)
+	}
+	return diagnostics
+}
+
+func manifestFeaturesMap(ctx context.Context, features map[string]manifestFeature, diagnostics *diag.Diagnostics) types.Map {
+	documents := make(map[string]string, len(features))
+	for name, feature := range features {
+		documents[name] = feature.canonicalJSON()
+	}
+
+	value, diags := types.MapValueFrom(ctx, types.StringType, documents)
+	diagnostics.Append(diags...)
+	return value
+}
+
+func decodeManifestFeatures(ctx context.Context, value types.Map, diagnostics *diag.Diagnostics) map[string]manifestFeature {
+	var documents map[string]string
+	diagnostics.Append(value.ElementsAs(ctx, &documents, false)...)
+
+	features := make(map[string]manifestFeature, len(documents))
+	for name, document := range documents {
+		var feature manifestFeature
+		if err := json.Unmarshal([]byte(document), &feature); err != nil {
+			diagnostics.AddError("Unable to read feature manifest state", fmt.Sprintf("Feature %s: %s", name, err))
+			continue
+		}
+		features[name] = feature
+	}
+	return features
+}
+
+// manifestDocumentFeatures returns the features of a document that was applied before, or nil when there is none, such
+// as after an import.
+func manifestDocumentFeatures(ctx context.Context, document types.Dynamic) map[string]manifestFeature {
+	if document.IsNull() || !isManifestDocumentKnown(ctx, document) {
+		return nil
+	}
+	features, err := parseManifestDocument(ctx, document)
+	if err != nil {
+		return nil
+	}
+	return features
+}
+
+// isManifestDocumentKnown reports whether every part of a document is known. An object document can be partly unknown
+// while planning, for example when one strategy refers to another resource.
+func isManifestDocumentKnown(ctx context.Context, document types.Dynamic) bool {
+	if document.IsUnknown() || document.IsUnderlyingValueUnknown() {
+		return false
+	}
+	value, err := document.ToTerraformValue(ctx)
+	return err == nil && value.IsFullyKnown()
+}
+
+// parseManifestDocument reads the manifest in a document attribute. Strings are YAML or JSON already, objects are
+// written as JSON first so both are read the same way.
+func parseManifestDocument(ctx context.Context, document types.Dynamic) (map[string]manifestFeature, error) {
+	if text, ok := document.UnderlyingValue().(types.String); ok {
+		return parseFeatureManifest(text.ValueString())
+	}
+
+	value, err := document.ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	decoded, err := manifestDocumentValue(value)
+	if err != nil {
+		return nil, err
+	}
+	text, err := json.Marshal(decoded)
+	if err != nil {
+		return nil, err
+	}
+	return parseFeatureManifest(string(text))
+}
+
+// manifestDocumentValue turns a Terraform value into the plain values encoding/json writes.
+func manifestDocumentValue(value tftypes.Value) (any, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	switch {
+	case value.Type().Is(tftypes.String):
+		var text string
+		err := value.As(&text)
+		return text, err
+	case value.Type().Is(tftypes.Bool):
+		var boolean bool
+		err := value.As(&boolean)
+		return boolean, err
+	case value.Type().Is(tftypes.Number):
+		number := new(big.Float)
+		if err := value.As(&number); err != nil {
+			return nil, err
+		}
+		if number.IsInt() {
+			integer, _ := number.Int(nil)
+			return json.Number(integer.String()), nil
+		}
+		return json.Number(number.Text('f', -1)), nil
+	case value.Type().Is(tftypes.Object{}), value.Type().Is(tftypes.Map{}):
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return nil, err
+		}
+		decoded := make(map[string]any, len(attributes))
+		for name, attribute := range attributes {
+			element, err := manifestDocumentValue(attribute)
+			if err != nil {
+				return nil, err
+			}
+			decoded[name] = element
+		}
+		return decoded, nil
+	case value.Type().Is(tftypes.List{}), value.Type().Is(tftypes.Set{}), value.Type().Is(tftypes.Tuple{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, err
+		}
+		decoded := make([]any, 0, len(elements))
+		for _, element := range elements {
+			elementValue, err := manifestDocumentValue(element)
+			if err != nil {
+				return nil, err
+			}
+			decoded = append(decoded, elementValue)
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("the document can't hold a value of type %s", value.Type())
+	}
+}
+
+// withUnmanagedFeatures adds the features the manifest never listed to the desired ones, as they currently are, since
+// they're left alone unless archive_unmanaged is set.
+func withUnmanagedFeatures(desired map[string]manifestFeature, previous map[string]manifestFeature, current map[string]manifestFeature) map[string]manifestFeature {
+	features := maps.Clone(desired)
+	for name, feature := range current {
+		_, wanted := desired[name]
+		_, managed := previous[name]
+		if !wanted && !managed {
+			features[name] = feature
+		}
+	}
+	return features
+}
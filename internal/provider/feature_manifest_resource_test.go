@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeatureManifestResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_project" "manifest" {
+						id   = "tf-manifest"
+						name = "Terraform manifest"
+					}
+
+					resource "unleash_feature_manifest" "flags" {
+						project  = unleash_project.manifest.id
+						document = <<-YAML
+							features:
+							  tf-manifest-checkout:
+							    description: New checkout flow
+							    environments:
+							      development:
+							        enabled: true
+							        strategies:
+							          - name: flexibleRollout
+							            parameters:
+							              rollout: 50
+							              stickiness: default
+							              groupId: tf-manifest-checkout
+							  tf-manifest-search:
+							    type: experiment
+						YAML
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_manifest.flags", "features.%", "2"),
+					resource.TestCheckResourceAttrSet("unleash_feature_manifest.flags", "features.tf-manifest-checkout"),
+					resource.TestCheckResourceAttr("unleash_feature_manifest.flags", "features.tf-manifest-search", `{"type":"experiment"}`),
+					testAccCheckFeatureEnabled(t, "tf-manifest", "tf-manifest-checkout", "development", true),
+				),
+			},
+			{
+				// features created outside of the manifest are left alone
+				PreConfig: func() { testAccCreateFeature(t, "tf-manifest", "tf-manifest-from-ui") },
+				Config: `
+					resource "unleash_project" "manifest" {
+						id   = "tf-manifest"
+						name = "Terraform manifest"
+					}
+
+					resource "unleash_feature_manifest" "flags" {
+						project = unleash_project.manifest.id
+						document = {
+							features = {
+								tf-manifest-checkout = {
+									description = "New checkout flow"
+									environments = {
+										development = { enabled = false }
+									}
+								}
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("unleash_feature_manifest.flags", "features.tf-manifest-search"),
+					resource.TestCheckResourceAttr("unleash_feature_manifest.flags", "features.tf-manifest-checkout", `{"type":"release","description":"New checkout flow","environments":{"development":{"enabled":false}}}`),
+					testAccCheckFeatureEnabled(t, "tf-manifest", "tf-manifest-checkout", "development", false),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_project" "manifest" {
+						id   = "tf-manifest"
+						name = "Terraform manifest"
+					}
+
+					resource "unleash_feature_manifest" "flags" {
+						project = unleash_project.manifest.id
+						document = {
+							features = {
+								tf-manifest-checkout = {
+									description = "New checkout flow"
+									environments = {
+										development = { enabled = false }
+									}
+								}
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_manifest.flags", "features.%", "2"),
+					resource.TestCheckResourceAttrSet("unleash_feature_manifest.flags", "features.tf-manifest-from-ui"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_project" "manifest" {
+						id   = "tf-manifest"
+						name = "Terraform manifest"
+					}
+
+					resource "unleash_feature_manifest" "flags" {
+						project           = unleash_project.manifest.id
+						archive_unmanaged = true
+						document = {
+							features = {
+								tf-manifest-checkout = {
+									description = "New checkout flow"
+									environments = {
+										development = { enabled = false }
+									}
+								}
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_manifest.flags", "features.%", "1"),
+					resource.TestCheckNoResourceAttr("unleash_feature_manifest.flags", "features.tf-manifest-from-ui"),
+				),
+			},
+			{
+				ResourceName:                         "unleash_feature_manifest.flags",
+				ImportState:                          true,
+				ImportStateId:                        "tf-manifest",
+				ImportStateVerifyIdentifierAttribute: "project",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"document", "features"},
+			},
+		},
+	})
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testFeatureManifest = `
+features:
+  checkout:
+    description: New checkout flow
+    tags:
+      - type: simple
+        value: payments
+    environments:
+      production:
+        enabled: true
+        strategies:
+          - name: flexibleRollout
+            parameters:
+              rollout: 50
+              stickiness: default
+              groupId: checkout
+            constraints:
+              - contextName: userId
+                operator: IN
+                values: ["1", "2"]
+            variants:
+              - name: blue
+      development:
+        enabled: false
+  search:
+    type: experiment
+`
+
+func TestParseFeatureManifest(t *testing.T) {
+	features, err := parseFeatureManifest(testFeatureManifest)
+	require.NoError(t, err)
+	require.Len(t, features, 2)
+
+	checkout := features["checkout"]
+	assert.Equal(t, "release", checkout.Type, "the type defaults to release")
+	assert.Equal(t, []string{"development", "production"}, checkout.environmentNames())
+	require.Len(t, checkout.Environments["production"].Strategies, 1)
+	strategy := checkout.Environments["production"].Strategies[0]
+	assert.Equal(t, "50", strategy.Parameters["rollout"], "numbers are read as strings")
+	assert.Equal(t, "variable", strategy.Variants[0].WeightType)
+	assert.Equal(t, "default", strategy.Variants[0].Stickiness)
+	assert.Empty(t, features["search"].environmentNames())
+	assert.NotNil(t, features["search"].environmentNames())
+
+	asJson, err := parseFeatureManifest(`{"features": {"search": {"type": "experiment"}}}`)
+	require.NoError(t, err)
+	assert.Equal(t, features["search"], asJson["search"])
+
+	empty, err := parseFeatureManifest("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	_, err = parseFeatureManifest("features:\n  checkout:\n    enabled: true\n")
+	assert.ErrorContains(t, err, "field enabled not found")
+
+	_, err = parseFeatureManifest("features:\n  checkout:\n    environments:\n      production:\n        strategies:\n          - parameters: {}\n")
+	assert.ErrorContains(t, err, "strategy 1 of feature checkout in production has no name")
+
+	_, err = parseFeatureManifest("features:\n  checkout:\n    environments:\n      production:\n        enabled: true\n")
+	assert.ErrorContains(t, err, "feature checkout is enabled in production without strategies")
+}
+
+func TestParseManifestDocument(t *testing.T) {
+	ctx := context.Background()
+	object := func(attributes map[string]attr.Value) types.Object {
+		attributeTypes := make(map[string]attr.Type, len(attributes))
+		for name, value := range attributes {
+			attributeTypes[name] = value.Type(ctx)
+		}
+		return types.ObjectValueMust(attributeTypes, attributes)
+	}
+
+	strategy := object(map[string]attr.Value{
+		"name": types.StringValue("flexibleRollout"),
+		"parameters": object(map[string]attr.Value{
+			"rollout":    types.NumberValue(big.NewFloat(50)),
+			"stickiness": types.StringValue("default"),
+		}),
+	})
+	strategies := types.TupleValueMust([]attr.Type{strategy.Type(ctx)}, []attr.Value{strategy})
+	document := types.DynamicValue(object(map[string]attr.Value{
+		"features": object(map[string]attr.Value{
+			"checkout": object(map[string]attr.Value{
+				"environments": object(map[string]attr.Value{
+					"production": object(map[string]attr.Value{
+						"enabled":    types.BoolValue(true),
+						"strategies": strategies,
+					}),
+				}),
+			}),
+		}),
+	}))
+
+	require.True(t, isManifestDocumentKnown(ctx, document))
+	features, err := parseManifestDocument(ctx, document)
+	require.NoError(t, err)
+
+	expected, err := parseFeatureManifest("features:\n  checkout:\n    environments:\n      production:\n        enabled: true\n        strategies:\n          - name: flexibleRollout\n            parameters: {rollout: 50, stickiness: default}\n")
+	require.NoError(t, err)
+	assert.Equal(t, expected, features)
+
+	fromString, err := parseManifestDocument(ctx, types.DynamicValue(types.StringValue(testFeatureManifest)))
+	require.NoError(t, err)
+	assert.Len(t, fromString, 2)
+
+	partlyUnknown := types.DynamicValue(object(map[string]attr.Value{"features": types.DynamicUnknown()}))
+	assert.False(t, isManifestDocumentKnown(ctx, partlyUnknown))
+}
+
+func TestManifestFeatureFromApiIgnoresParametersUnleashAdds(t *testing.T) {
+	features, err := parseFeatureManifest(`
+features:
+  checkout:
+    environments:
+      production:
+        enabled: true
+        strategies:
+          - name: flexibleRollout
+            parameters:
+              rollout: 50
+          - name: default
+`)
+	require.NoError(t, err)
+
+	var feature featureApiModel
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "checkout",
+		"type": "release",
+		"environments": [
+			{"name": "production", "enabled": true, "strategies": [
+				{"id": "2", "name": "default", "sortOrder": 1, "parameters": {}, "constraints": [], "variants": [], "segments": []},
+				{"id": "1", "name": "flexibleRollout", "sortOrder": 0, "parameters": {"rollout": "50", "stickiness": "default", "groupId": "checkout"}, "constraints": [], "variants": [], "segments": []}
+			]}
+		]
+	}`), &feature))
+
+	checkout := features["checkout"]
+	assert.Equal(t, checkout.canonicalJSON(), manifestFeatureFromApi(feature, nil, &checkout).canonicalJSON())
+
+	unmanaged := manifestFeatureFromApi(feature, nil, nil)
+	assert.Equal(t, "checkout", unmanaged.Environments["production"].Strategies[0].Parameters["groupId"], "unmanaged features are read with every parameter")
+
+	feature.Environments[0].Strategies[1].Parameters["rollout"] = "25"
+	assert.NotEqual(t, checkout.canonicalJSON(), manifestFeatureFromApi(feature, nil, &checkout).canonicalJSON(), "configured parameters are still compared")
+}
+
+func TestWithUnmanagedFeatures(t *testing.T) {
+	desired := map[string]manifestFeature{"checkout": {Type: "release"}}
+	previous := map[string]manifestFeature{"checkout": {Type: "release"}, "legacy": {Type: "release"}}
+	current := map[string]manifestFeature{
+		"checkout":   {Type: "experiment"},
+		"legacy":     {Type: "release"},
+		"created-ui": {Type: "kill-switch"},
+	}
+
+	assert.Equal(t, map[string]manifestFeature{
+		"checkout":   {Type: "release"},
+		"created-ui": {Type: "kill-switch"},
+	}, withUnmanagedFeatures(desired, previous, current), "features that left the manifest are archived, the others are kept")
+}
+
+func TestManifestFeatureFromApiMatchesManifest(t *testing.T) {
+	features, err := parseFeatureManifest(testFeatureManifest)
+	require.NoError(t, err)
+
+	var feature featureApiModel
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "checkout",
+		"type": "release",
+		"description": "New checkout flow",
+		"impressionData": false,
+		"environments": [
+			{"name": "development", "enabled": false, "strategies": []},
+			{"name": "production", "enabled": true, "strategies": [{
+				"id": "1",
+				"name": "flexibleRollout",
+				"title": null,
+				"disabled": false,
+				"sortOrder": 0,
+				"parameters": {"rollout": "50", "stickiness": "default", "groupId": "checkout"},
+				"constraints": [{"contextName": "userId", "operator": "IN", "values": ["1", "2"], "inverted": false, "caseInsensitive": false}],
+				"variants": [{"name": "blue", "weight": 1000, "weightType": "variable", "stickiness": "default"}],
+				"segments": []
+			}]},
+			{"name": "staging", "enabled": true, "strategies": []}
+		]
+	}`), &feature))
+	tags := []tagApiModel{{Type: "simple", Value: "payments"}}
+
+	checkout := features["checkout"]
+	managed := manifestFeatureFromApi(feature, tags, &checkout)
+	assert.Equal(t, features["checkout"].canonicalJSON(), managed.canonicalJSON())
+
+	unmanaged := manifestFeatureFromApi(feature, tags, nil)
+	assert.Equal(t, []string{"production", "staging"}, unmanaged.environmentNames(), "without a manifest only environments with something in them are read")
+}
+
+func TestFeatureManifestReconcileFeature(t *testing.T) {
+	var lock sync.Mutex
+	var requests []string
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		lock.Unlock()
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`[{"id": "old", "name": "default"}]`))
+		}
+	})
+
+	features, err := parseFeatureManifest(testFeatureManifest)
+	require.NoError(t, err)
+	desired := features["checkout"]
+	current := manifestFeature{
+		Type:         "release",
+		Description:  "New checkout flow",
+		Environments: map[string]manifestEnvironment{"development": {Enabled: true}},
+	}
+
+	r := &featureManifestResource{client: client}
+	var diags diag.Diagnostics
+	r.reconcileFeature(context.Background(), "default", "checkout", &current, &desired, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+
+	assert.Equal(t, []string{
+		"PUT /api/admin/features/checkout/tags",
+		"POST /api/admin/projects/default/features/checkout/environments/development/off",
+		"GET /api/admin/projects/default/features/checkout/environments/production/strategies",
+		"POST /api/admin/projects/default/features/checkout/environments/production/strategies",
+		"DELETE /api/admin/projects/default/features/checkout/environments/production/strategies/old",
+		"POST /api/admin/projects/default/features/checkout/environments/production/on",
+	}, requests)
+
+	requests = nil
+	r.reconcileFeature(context.Background(), "default", "legacy", &current, nil, &diags)
+	require.False(t, diags.HasError(), "%v", diags)
+	assert.Equal(t, []string{"DELETE /api/admin/projects/default/features/legacy"}, requests)
+}
+
+func TestEachFeatureKeepsDiagnosticsInOrder(t *testing.T) {
+	diags := eachFeature([]string{"a", "b", "c"}, func(name string, diagnostics *diag.Diagnostics) {
+		if name != "b" {
+			diagnostics.AddError("Failed", name)
+		}
+	})
+
+	require.Len(t, diags, 2)
+	assert.Equal(t, "a", diags[0].Detail())
+	assert.Equal(t, "c", diags[1].Detail())
+}
+
+func TestFeatureManifestReconcileOnlyArchivesManagedFeatures(t *testing.T) {
+	archive := func(archiveUnmanaged bool) []string {
+		var lock sync.Mutex
+		var archived []string
+		client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == http.MethodDelete:
+				lock.Lock()
+				archived = append(archived, r.URL.Path)
+				lock.Unlock()
+				w.WriteHeader(http.StatusAccepted)
+			case r.URL.Path == "/api/admin/projects/default/features":
+				_, _ = w.Write([]byte(`{"features": [{"name": "checkout"}, {"name": "legacy"}, {"name": "created-in-ui"}]}`))
+			default:
+				_, _ = w.Write([]byte(`{"name": "feature", "type": "release"}`))
+			}
+		})
+
+		plan := featureManifestResourceModel{
+			Project:          types.StringValue("default"),
+			Document:         types.DynamicValue(types.StringValue("features:\n  checkout: {}\n")),
+			ArchiveUnmanaged: types.BoolValue(archiveUnmanaged),
+			Features:         types.MapNull(types.StringType),
+		}
+		previous := map[string]manifestFeature{"checkout": {Type: "release"}, "legacy": {Type: "release"}}
+
+		r := &featureManifestResource{client: client}
+		var diags diag.Diagnostics
+		require.True(t, r.reconcile(context.Background(), &plan, previous, &diags), "%v", diags)
+		slices.Sort(archived)
+		return archived
+	}
+
+	assert.Equal(t, []string{"/api/admin/projects/default/features/legacy"}, archive(false))
+	assert.Equal(t, []string{
+		"/api/admin/projects/default/features/created-in-ui",
+		"/api/admin/projects/default/features/legacy",
+	}, archive(true))
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &featureMetricsDataSource{}
+	_ datasource.DataSourceWithConfigure = &featureMetricsDataSource{}
+)
+
+// defaultFeatureMetricsHours is the window used when none is configured. Unleash keeps raw metrics for 48 hours.
+const defaultFeatureMetricsHours = 24
+
+func NewFeatureMetricsDataSource() datasource.DataSource {
+	return &featureMetricsDataSource{}
+}
+
+type featureMetricsDataSource struct {
+	client *unleash.APIClient
+}
+
+type featureMetricsDataSourceModel struct {
+	Project      types.String                     `tfsdk:"project"`
+	Feature      types.String                     `tfsdk:"feature"`
+	Hours        types.Int64                      `tfsdk:"hours"`
+	LastSeenAt   types.String                     `tfsdk:"last_seen_at"`
+	Applications []types.String                   `tfsdk:"applications"`
+	Environments []featureEnvironmentMetricsModel `tfsdk:"environments"`
+}
+
+type featureEnvironmentMetricsModel struct {
+	Name         types.String   `tfsdk:"name"`
+	Yes          types.Int64    `tfsdk:"yes"`
+	No           types.Int64    `tfsdk:"no"`
+	LastSeenAt   types.String   `tfsdk:"last_seen_at"`
+	Applications []types.String `tfsdk:"applications"`
+}
+
+type featureMetricsApiModel struct {
+	Data []featureMetricsBucketApiModel `json:"data"`
+}
+
+type featureMetricsBucketApiModel struct {
+	AppName     string          `json:"appName"`
+	Environment string          `json:"environment"`
+	Timestamp   json.RawMessage `json:"timestamp"`
+	Yes         int64           `json:"yes"`
+	No          int64           `json:"no"`
+}
+
+type featureUsageApiModel struct {
+	SeenApplications []string `json:"seenApplications"`
+}
+
+func (d *featureMetricsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *featureMetricsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_metrics"
+}
+
+func (d *featureMetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch how often a feature flag was evaluated, per environment, and which applications use it. Counts come from the metrics SDKs report, which Unleash keeps for 48 hours.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project of the feature.",
+				Required:    true,
+			},
+			"feature": schema.StringAttribute{
+				Description: "The name of the feature.",
+				Required:    true,
+			},
+			"hours": schema.Int64Attribute{
+				Description: "How many hours back to count evaluations, between 1 and 48. Defaults to 24.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 48),
+				},
+			},
+			"last_seen_at": schema.StringAttribute{
+				Description: "When an SDK last reported the feature in any environment, or null if it never did.",
+				Computed:    true,
+			},
+			"applications": schema.ListAttribute{
+				Description: "The applications that have reported the feature.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"environments": schema.ListNestedAttribute{
+				Description: "The metrics of the feature in each environment.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the environment.",
+							Computed:    true,
+						},
+						"yes": schema.Int64Attribute{
+							Description: "How many times the feature evaluated to enabled within the window.",
+							Computed:    true,
+						},
+						"no": schema.Int64Attribute{
+							Description: "How many times the feature evaluated to disabled within the window.",
+							Computed:    true,
+						},
+						"last_seen_at": schema.StringAttribute{
+							Description: "When an SDK last reported the feature in the environment, or null if it never did.",
+							Computed:    true,
+						},
+						"applications": schema.ListAttribute{
+							Description: "The applications that reported the feature in the environment within the window.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *featureMetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature metrics data source")
+	var state featureMetricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var feature featureApiModel
+	httpRes, err := cachedAdminGet(ctx, d.client, featurePath(state.Project.ValueString(), state.Feature.ValueString()), &feature)
+	if isNotFoundResponse(httpRes) {
+		resp.Diagnostics.AddError(
+			"Feature not found",
+			fmt.Sprintf("Feature %s doesn't exist in project %s.", state.Feature.ValueString(), state.Project.ValueString()),
+		)
+		return
+	}
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	metricsPath := "/api/admin/client-metrics/features/" + url.PathEscape(state.Feature.ValueString())
+
+	var metrics featureMetricsApiModel
+	httpRes, err = cachedAdminGet(ctx, d.client, metricsPath+"/raw", &metrics)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	var usage featureUsageApiModel
+	httpRes, err = cachedAdminGet(ctx, d.client, metricsPath, &usage)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	hours := int64(defaultFeatureMetricsHours)
+	if !state.Hours.IsNull() {
+		hours = state.Hours.ValueInt64()
+	}
+	since := time.Now().Add(-time.Duration(hours) * time.Hour)
+
+	state.LastSeenAt = types.StringPointerValue(feature.LastSeenAt)
+	state.Applications = stringValues(slices.Sorted(slices.Values(usage.SeenApplications)))
+	state.Environments = flattenFeatureEnvironmentMetrics(feature.Environments, metrics.Data, since, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading feature metrics data source", map[string]any{"success": true})
+}
+
+// flattenFeatureEnvironmentMetrics adds up the hourly buckets reported since the start of the window for every
+// environment of the feature. Buckets with a timestamp that can't be read aren't counted, which adds a warning.
+func flattenFeatureEnvironmentMetrics(environments []featureEnvironmentApiModel, buckets []featureMetricsBucketApiModel, since time.Time, diagnostics *diag.Diagnostics) []featureEnvironmentMetricsModel {
+	models := make([]featureEnvironmentMetricsModel, 0, len(environments))
+
+	var unreadable []string
+	for _, bucket := range buckets {
+		if _, ok := parseMetricsTimestamp(bucket.Timestamp); !ok {
+			unreadable = append(unreadable, string(bucket.Timestamp))
+		}
+	}
+	if len(unreadable) > 0 {
+		diagnostics.AddWarning(
+			"Unreadable metrics timestamps",
+			fmt.Sprintf("%d metrics buckets have a timestamp that isn't RFC 3339 or a UNIX timestamp, such as %s, so they aren't counted.", len(unreadable), unreadable[0]),
+		)
+	}
+
+	for _, environment := range environments {
+		var yes, no int64
+		applications := []string{}
+		for _, bucket := range buckets {
+			if bucket.Environment != environment.Name {
+				continue
+			}
+			timestamp, ok := parseMetricsTimestamp(bucket.Timestamp)
+			if !ok || timestamp.Before(since) {
+				continue
+			}
+			yes += bucket.Yes
+			no += bucket.No
+			if bucket.AppName != "" && !slices.Contains(applications, bucket.AppName) {
+				applications = append(applications, bucket.AppName)
+			}
+		}
+		slices.Sort(applications)
+
+		models = append(models, featureEnvironmentMetricsModel{
+			Name:         types.StringValue(environment.Name),
+			Yes:          types.Int64Value(yes),
+			No:           types.Int64Value(no),
+			LastSeenAt:   types.StringPointerValue(environment.LastSeenAt),
+			Applications: stringValues(applications),
+		})
+	}
+
+	return models
+}
+
+// parseMetricsTimestamp reads a timestamp that Unleash sends either as an RFC 3339 string or as a UNIX timestamp.
+func parseMetricsTimestamp(raw json.RawMessage) (time.Time, bool) {
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		timestamp, err := time.Parse(time.RFC3339, text)
+		return timestamp, err == nil
+	}
+
+	var seconds int64
+	if err := json.Unmarshal(raw, &seconds); err == nil {
+		return time.Unix(seconds, 0), true
+	}
+
+	return time.Time{}, false
+}
+
+func stringValues(values []string) []types.String {
+	result := make([]types.String, 0, len(values))
+	for _, value := range values {
+		result = append(result, types.StringValue(value))
+	}
+	return result
+}
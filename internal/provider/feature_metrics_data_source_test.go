@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeatureMetricsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-metrics")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unleash_feature_metrics" "metrics" {
+						project = "default"
+						feature = "tf-metrics"
+						hours   = 48
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("data.unleash_feature_metrics.metrics", "last_seen_at"),
+					resource.TestCheckResourceAttr("data.unleash_feature_metrics.metrics", "applications.#", "0"),
+					resource.TestCheckResourceAttrSet("data.unleash_feature_metrics.metrics", "environments.0.name"),
+					resource.TestCheckResourceAttr("data.unleash_feature_metrics.metrics", "environments.0.yes", "0"),
+					resource.TestCheckResourceAttr("data.unleash_feature_metrics.metrics", "environments.0.no", "0"),
+				),
+			},
+			{
+				Config: `
+					data "unleash_feature_metrics" "missing" {
+						project = "default"
+						feature = "tf-metrics-does-not-exist"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Feature not found"),
+			},
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenFeatureEnvironmentMetrics(t *testing.T) {
+	now := time.Now().UTC()
+	recent := json.RawMessage(`"` + now.Add(-time.Hour).Format(time.RFC3339) + `"`)
+	old := json.RawMessage(`"` + now.Add(-30*time.Hour).Format(time.RFC3339) + `"`)
+	unix := json.RawMessage(strconv.FormatInt(now.Add(-2*time.Hour).Unix(), 10))
+
+	lastSeen := now.Format(time.RFC3339)
+	environments := []featureEnvironmentApiModel{
+		{Name: "development", LastSeenAt: &lastSeen},
+		{Name: "production"},
+	}
+	buckets := []featureMetricsBucketApiModel{
+		{AppName: "web", Environment: "development", Timestamp: recent, Yes: 3, No: 1},
+		{AppName: "api", Environment: "development", Timestamp: unix, Yes: 2, No: 2},
+		{AppName: "web", Environment: "development", Timestamp: recent, Yes: 1, No: 0},
+		{AppName: "batch", Environment: "development", Timestamp: old, Yes: 100, No: 100},
+		{AppName: "web", Environment: "development", Timestamp: json.RawMessage(`"last tuesday"`), Yes: 50, No: 50},
+	}
+
+	var diags diag.Diagnostics
+	models := flattenFeatureEnvironmentMetrics(environments, buckets, now.Add(-24*time.Hour), &diags)
+	require.Len(t, models, 2)
+	require.Len(t, diags, 1, "unreadable buckets are reported")
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Contains(t, diags[0].Detail(), `"last tuesday"`)
+
+	assert.Equal(t, "development", models[0].Name.ValueString())
+	assert.Equal(t, int64(6), models[0].Yes.ValueInt64())
+	assert.Equal(t, int64(3), models[0].No.ValueInt64())
+	assert.Equal(t, lastSeen, models[0].LastSeenAt.ValueString())
+	assert.Equal(t, stringValues([]string{"api", "web"}), models[0].Applications, "buckets outside the window don't count")
+
+	assert.Equal(t, int64(0), models[1].Yes.ValueInt64())
+	assert.True(t, models[1].LastSeenAt.IsNull())
+	assert.Empty(t, models[1].Applications)
+}
+
+func TestCachedAdminGet(t *testing.T) {
+	var requests atomic.Int32
+	failing := true
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Path == "/api/admin/client-metrics/features/flaky" && failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write([]byte(`{"seenApplications": ["web"]}`))
+	})
+
+	for range 3 {
+		var usage featureUsageApiModel
+		httpRes, err := cachedAdminGet(context.Background(), client, "/api/admin/client-metrics/features/checkout", &usage)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, httpRes.StatusCode)
+		assert.Equal(t, []string{"web"}, usage.SeenApplications)
+	}
+	assert.Equal(t, int32(1), requests.Load(), "the response is reused")
+
+	_, err := cachedAdminGet(context.Background(), client, "/api/admin/client-metrics/features/flaky", nil)
+	require.Error(t, err)
+	failing = false
+	_, err = cachedAdminGet(context.Background(), client, "/api/admin/client-metrics/features/flaky", nil)
+	require.NoError(t, err, "failed requests aren't cached")
+	assert.Equal(t, int32(3), requests.Load())
+}
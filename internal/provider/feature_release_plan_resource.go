@@ -0,0 +1,619 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &featureReleasePlanResource{}
+	_ resource.ResourceWithConfigure      = &featureReleasePlanResource{}
+	_ resource.ResourceWithImportState    = &featureReleasePlanResource{}
+	_ resource.ResourceWithValidateConfig = &featureReleasePlanResource{}
+	_ resource.ResourceWithModifyPlan     = &featureReleasePlanResource{}
+)
+
+func NewFeatureReleasePlanResource() resource.Resource {
+	return &featureReleasePlanResource{}
+}
+
+type featureReleasePlanResource struct {
+	client *unleash.APIClient
+}
+
+type featureReleasePlanResourceModel struct {
+	Id              types.String                       `tfsdk:"id"`
+	Project         types.String                       `tfsdk:"project"`
+	Feature         types.String                       `tfsdk:"feature"`
+	Environment     types.String                       `tfsdk:"environment"`
+	TemplateId      types.String                       `tfsdk:"template_id"`
+	Name            types.String                       `tfsdk:"name"`
+	ActiveMilestone types.String                       `tfsdk:"active_milestone"`
+	Milestones      []featureReleasePlanMilestoneModel `tfsdk:"milestones"`
+
+	ChangeRequestMode    types.String `tfsdk:"change_request_mode"`
+	ChangeRequestWait    types.Bool   `tfsdk:"change_request_wait"`
+	ChangeRequestTimeout types.String `tfsdk:"change_request_timeout"`
+	ChangeRequestId      types.String `tfsdk:"change_request_id"`
+	ChangeRequestStatus  types.String `tfsdk:"change_request_status"`
+}
+
+type featureReleasePlanMilestoneModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	StartedAt types.String `tfsdk:"started_at"`
+}
+
+func (r *featureReleasePlanResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *featureReleasePlanResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_release_plan"
+}
+
+func (r *featureReleasePlanResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Applies a release plan template to a feature in one environment and controls which milestone is active. Changing `active_milestone` starts that milestone. When the environment has change requests enabled, `change_request_mode = \"submit\"` sends changes through a change request. Release plans are only available in Unleash Enterprise.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the release plan.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "The project the feature belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"feature": schema.StringAttribute{
+				Description: "The name of the feature.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Description: "The environment the release plan rolls the feature out in.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Description: "The id of the release plan template to apply.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the release plan, taken from the template.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active_milestone": schema.StringAttribute{
+				Description: "The name of the active milestone. Changing it starts that milestone. Leave it unset to only track the milestone that's active in Unleash.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"milestones": schema.ListNestedAttribute{
+				Description: "The milestones of the release plan in rollout order, with when each one was started.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The id of the milestone.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the milestone.",
+							Computed:    true,
+						},
+						"started_at": schema.StringAttribute{
+							Description: "When the milestone was started, if it has been.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, attribute := range changeRequestResourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (r *featureReleasePlanResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config featureReleasePlanResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newChangeRequestSettings(config.ChangeRequestMode, config.ChangeRequestWait, config.ChangeRequestTimeout, &resp.Diagnostics)
+}
+
+// ModifyPlan checks that the active milestone exists in the template, so typos are reported before anything is
+// applied. It also warns when the write would skip change requests the environment requires.
+func (r *featureReleasePlanResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan featureReleasePlanResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.TemplateId.IsUnknown() && !plan.ActiveMilestone.IsUnknown() && !plan.ActiveMilestone.IsNull() {
+		var template releasePlanTemplateApiModel
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, releasePlanTemplatePath(plan.TemplateId.ValueString()), nil, &template)
+		if isNotFoundResponse(httpRes) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("template_id"),
+				"Release plan template not found",
+				fmt.Sprintf("Release plan template %s does not exist.", plan.TemplateId.ValueString()),
+			)
+			return
+		}
+		if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+
+		names := make([]string, 0, len(template.Milestones))
+		for _, milestone := range sortMilestones(template.Milestones) {
+			names = append(names, milestone.Name)
+		}
+		if findMilestone(template.Milestones, plan.ActiveMilestone.ValueString()) == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("active_milestone"),
+				"Milestone not found",
+				fmt.Sprintf("Release plan template %s has no milestone named %s. Available milestones: %s.", template.Name, plan.ActiveMilestone.ValueString(), strings.Join(names, ", ")),
+			)
+			return
+		}
+	}
+
+	if plan.Project.IsUnknown() || plan.Environment.IsUnknown() {
+		return
+	}
+
+	if req.State.Raw.IsNull() || !req.Plan.Raw.Equal(req.State.Raw) {
+		warnAboutDirectWrites(ctx, r.client, plan.Project.ValueString(), plan.Environment.ValueString(), plan.ChangeRequestMode, "this release plan", &resp.Diagnostics)
+	}
+}
+
+func (r *featureReleasePlanResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import feature release plan resource")
+
+	// The unique identifier for a feature release plan is: "<project>:<feature>:<environment>:<plan id>"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format '<project>:<feature>:<environment>:<plan id>'. Example: 'default:new-checkout:production:01JB9GGTGQYEQ9D40R17T3YVW3'",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[3])...)
+
+	tflog.Debug(ctx, "Finished importing feature release plan resource", map[string]any{"success": true})
+}
+
+func (r *featureReleasePlanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create feature release plan resource")
+	var plan featureReleasePlanResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	settings := newChangeRequestSettings(plan.ChangeRequestMode, plan.ChangeRequestWait, plan.ChangeRequestTimeout, &resp.Diagnostics)
+	environment, ok := changeRequestEnvironment(ctx, r.client, plan.Project.ValueString(), plan.Environment.ValueString(), settings, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ChangeRequestId = types.StringNull()
+	plan.ChangeRequestStatus = types.StringNull()
+	request := releasePlanTemplateIdApiModel{TemplateId: plan.TemplateId.ValueString()}
+
+	if environment != "" {
+		change := changeRequestChangeApiModel{
+			Feature: plan.Feature.ValueString(),
+			Action:  "addReleasePlan",
+			Payload: request,
+		}
+		if !r.submit(ctx, &plan, environment, change, settings, &resp.Diagnostics) {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		tflog.Debug(ctx, "Finished creating feature release plan resource", map[string]any{"success": true})
+		return
+	}
+
+	var releasePlan featureReleasePlanApiModel
+	plansPath := featureReleasePlansPath(plan.Project.ValueString(), plan.Feature.ValueString(), plan.Environment.ValueString())
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, plansPath, request, &releasePlan)
+	if !IsValidApiResponse(httpRes, []int{200, 201}, &resp.Diagnostics, err) {
+		return
+	}
+	plan.Id = types.StringValue(releasePlan.Id)
+
+	if !plan.ActiveMilestone.IsUnknown() && !plan.ActiveMilestone.IsNull() && !isActiveMilestone(releasePlan, plan.ActiveMilestone.ValueString()) {
+		if !r.startMilestone(ctx, plan, releasePlan, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	if !r.refresh(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating feature release plan resource", map[string]any{"success": true})
+}
+
+func (r *featureReleasePlanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature release plan resource")
+	var state featureReleasePlanResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultChangeRequestAttributes(&state.ChangeRequestMode, &state.ChangeRequestWait, &state.ChangeRequestTimeout)
+
+	if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+		var changeRequest changeRequestApiModel
+		httpRes, err := fetchChangeRequest(ctx, r.client, state.Project.ValueString(), state.ChangeRequestId.ValueString(), &changeRequest)
+		if isNotFoundResponse(httpRes) {
+			changeRequest.State = changeRequestStateCanceled
+		} else if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+		state.ChangeRequestStatus = types.StringValue(changeRequest.State)
+
+		// until the change request is done, the release plan in Unleash doesn't reflect the configuration yet
+		if isChangeRequestPending(changeRequest.State) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	var releasePlans []featureReleasePlanApiModel
+	plansPath := featureReleasePlansPath(state.Project.ValueString(), state.Feature.ValueString(), state.Environment.ValueString())
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, plansPath, nil, &releasePlans)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Feature.ValueString(), "Feature") {
+		return
+	}
+
+	releasePlan := findReleasePlan(releasePlans, state.Id, state.TemplateId)
+	if releasePlan == nil {
+		tflog.Warn(ctx, fmt.Sprintf("Feature %s no longer has the release plan in %s, removing from state", state.Feature.ValueString(), state.Environment.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.hydrateFromApi(*releasePlan)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading feature release plan resource", map[string]any{"success": true})
+}
+
+func (r *featureReleasePlanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update feature release plan resource")
+	var plan featureReleasePlanResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state featureReleasePlanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = state.Id
+	plan.Name = state.Name
+	plan.Milestones = state.Milestones
+
+	// only the change request settings changed, there's nothing to write
+	if plan.ActiveMilestone.IsUnknown() || plan.ActiveMilestone.Equal(state.ActiveMilestone) {
+		plan.ActiveMilestone = state.ActiveMilestone
+		plan.ChangeRequestId = state.ChangeRequestId
+		plan.ChangeRequestStatus = state.ChangeRequestStatus
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	if state.Id.IsNull() {
+		resp.Diagnostics.AddError(
+			"Release plan not added yet",
+			fmt.Sprintf("The release plan is added to %s once change request %s is applied. Milestones can be started after that.", plan.Feature.ValueString(), state.ChangeRequestId.ValueString()),
+		)
+		return
+	}
+
+	var releasePlans []featureReleasePlanApiModel
+	plansPath := featureReleasePlansPath(plan.Project.ValueString(), plan.Feature.ValueString(), plan.Environment.ValueString())
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, plansPath, nil, &releasePlans)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	releasePlan := findReleasePlan(releasePlans, state.Id, state.TemplateId)
+	if releasePlan == nil {
+		resp.Diagnostics.AddError(
+			"Release plan not found",
+			fmt.Sprintf("Feature %s no longer has release plan %s in %s.", plan.Feature.ValueString(), state.Id.ValueString(), plan.Environment.ValueString()),
+		)
+		return
+	}
+
+	settings := newChangeRequestSettings(plan.ChangeRequestMode, plan.ChangeRequestWait, plan.ChangeRequestTimeout, &resp.Diagnostics)
+	environment, ok := changeRequestEnvironment(ctx, r.client, plan.Project.ValueString(), plan.Environment.ValueString(), settings, &resp.Diagnostics)
+	if !ok || resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ChangeRequestId = types.StringNull()
+	plan.ChangeRequestStatus = types.StringNull()
+
+	if environment != "" {
+		milestone := findMilestone(releasePlan.Milestones, plan.ActiveMilestone.ValueString())
+		if milestone == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("active_milestone"),
+				"Milestone not found",
+				fmt.Sprintf("Release plan %s has no milestone named %s.", releasePlan.Name, plan.ActiveMilestone.ValueString()),
+			)
+			return
+		}
+
+		change := changeRequestChangeApiModel{
+			Feature: plan.Feature.ValueString(),
+			Action:  "startMilestone",
+			Payload: map[string]string{"planId": releasePlan.Id, "milestoneId": milestone.Id},
+		}
+		if !r.submit(ctx, &plan, environment, change, settings, &resp.Diagnostics) {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		tflog.Debug(ctx, "Finished updating feature release plan resource", map[string]any{"success": true})
+		return
+	}
+
+	if !r.startMilestone(ctx, plan, *releasePlan, &resp.Diagnostics) {
+		return
+	}
+
+	if !r.refresh(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating feature release plan resource", map[string]any{"success": true})
+}
+
+func (r *featureReleasePlanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete feature release plan resource")
+	var state featureReleasePlanResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Id.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Change request still open",
+			fmt.Sprintf("Change request %s that adds the release plan to %s is still open in Unleash. Reject or cancel it there to keep the release plan from being added.", state.ChangeRequestId.ValueString(), state.Feature.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	settings := newChangeRequestSettings(state.ChangeRequestMode, state.ChangeRequestWait, state.ChangeRequestTimeout, &resp.Diagnostics)
+	environment, ok := changeRequestEnvironment(ctx, r.client, state.Project.ValueString(), state.Environment.ValueString(), settings, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if environment != "" {
+		change := changeRequestChangeApiModel{
+			Feature: state.Feature.ValueString(),
+			Action:  "deleteReleasePlan",
+			Payload: map[string]string{"planId": state.Id.ValueString()},
+		}
+		changeRequest := submitChangeRequest(ctx, r.client, state.Project.ValueString(), environment, []changeRequestChangeApiModel{change}, settings, &resp.Diagnostics)
+		if changeRequest == nil || resp.Diagnostics.HasError() {
+			return
+		}
+
+		if changeRequest.State != changeRequestStateApplied {
+			resp.Diagnostics.AddWarning(
+				"Release plan removal waiting for change request",
+				fmt.Sprintf("Feature %s keeps its release plan in %s until change request %d is applied.", state.Feature.ValueString(), state.Environment.ValueString(), changeRequest.Id),
+			)
+		}
+	} else {
+		planPath := featureReleasePlansPath(state.Project.ValueString(), state.Feature.ValueString(), state.Environment.ValueString()) + "/" + url.PathEscape(state.Id.ValueString())
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, planPath, nil, nil)
+		if !isNotFoundResponse(httpRes) && !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting feature release plan resource", map[string]any{"success": true})
+}
+
+// submit sends a change to the release plan as a change request. Once the change request is applied, the plan is read
+// back from Unleash, until then the configured values are kept.
+func (r *featureReleasePlanResource) submit(ctx context.Context, plan *featureReleasePlanResourceModel, environment string, change changeRequestChangeApiModel, settings changeRequestSettings, diagnostics *diag.Diagnostics) bool {
+	changeRequest := submitChangeRequest(ctx, r.client, plan.Project.ValueString(), environment, []changeRequestChangeApiModel{change}, settings, diagnostics)
+	if changeRequest == nil || diagnostics.HasError() {
+		return false
+	}
+
+	plan.ChangeRequestId = types.StringValue(strconv.FormatInt(changeRequest.Id, 10))
+	plan.ChangeRequestStatus = types.StringValue(changeRequest.State)
+
+	if plan.Id.IsUnknown() {
+		plan.Id = types.StringNull()
+	}
+	if plan.Name.IsUnknown() {
+		plan.Name = types.StringNull()
+	}
+	if plan.ActiveMilestone.IsUnknown() {
+		plan.ActiveMilestone = types.StringNull()
+	}
+
+	if changeRequest.State != changeRequestStateApplied {
+		return true
+	}
+
+	// the configured milestone stays in state, a new plan only starts it on the next apply
+	activeMilestone := plan.ActiveMilestone
+	if !r.refresh(ctx, plan, diagnostics) {
+		return false
+	}
+	plan.ActiveMilestone = activeMilestone
+	return true
+}
+
+func (r *featureReleasePlanResource) startMilestone(ctx context.Context, plan featureReleasePlanResourceModel, releasePlan featureReleasePlanApiModel, diagnostics *diag.Diagnostics) bool {
+	milestone := findMilestone(releasePlan.Milestones, plan.ActiveMilestone.ValueString())
+	if milestone == nil {
+		diagnostics.AddAttributeError(
+			path.Root("active_milestone"),
+			"Milestone not found",
+			fmt.Sprintf("Release plan %s has no milestone named %s.", releasePlan.Name, plan.ActiveMilestone.ValueString()),
+		)
+		return false
+	}
+
+	startPath := featureReleasePlansPath(plan.Project.ValueString(), plan.Feature.ValueString(), plan.Environment.ValueString()) +
+		"/" + url.PathEscape(releasePlan.Id) + "/milestones/" + url.PathEscape(milestone.Id) + "/start"
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, startPath, nil, nil)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+// refresh reads the release plan back from Unleash after a write.
+func (r *featureReleasePlanResource) refresh(ctx context.Context, plan *featureReleasePlanResourceModel, diagnostics *diag.Diagnostics) bool {
+	var releasePlans []featureReleasePlanApiModel
+	plansPath := featureReleasePlansPath(plan.Project.ValueString(), plan.Feature.ValueString(), plan.Environment.ValueString())
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, plansPath, nil, &releasePlans)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return false
+	}
+
+	releasePlan := findReleasePlan(releasePlans, plan.Id, plan.TemplateId)
+	if releasePlan == nil {
+		diagnostics.AddError(
+			"Release plan not found",
+			fmt.Sprintf("Feature %s has no release plan based on template %s in %s.", plan.Feature.ValueString(), plan.TemplateId.ValueString(), plan.Environment.ValueString()),
+		)
+		return false
+	}
+
+	plan.hydrateFromApi(*releasePlan)
+	return true
+}
+
+func (m *featureReleasePlanResourceModel) hydrateFromApi(releasePlan featureReleasePlanApiModel) {
+	m.Id = types.StringValue(releasePlan.Id)
+	m.Name = types.StringValue(releasePlan.Name)
+	m.TemplateId = types.StringValue(releasePlan.ReleasePlanTemplateId)
+	m.ActiveMilestone = types.StringNull()
+
+	m.Milestones = make([]featureReleasePlanMilestoneModel, 0, len(releasePlan.Milestones))
+	for _, milestone := range sortMilestones(releasePlan.Milestones) {
+		if releasePlan.ActiveMilestoneId != nil && *releasePlan.ActiveMilestoneId == milestone.Id {
+			m.ActiveMilestone = types.StringValue(milestone.Name)
+		}
+
+		m.Milestones = append(m.Milestones, featureReleasePlanMilestoneModel{
+			Id:        types.StringValue(milestone.Id),
+			Name:      types.StringValue(milestone.Name),
+			StartedAt: types.StringPointerValue(milestone.StartedAt),
+		})
+	}
+}
+
+// findReleasePlan looks a release plan up by id. Plans added through a change request don't have an id in state
+// yet, those are matched on their template instead.
+func findReleasePlan(releasePlans []featureReleasePlanApiModel, id types.String, templateId types.String) *featureReleasePlanApiModel {
+	for i, releasePlan := range releasePlans {
+		if id.IsNull() || id.IsUnknown() {
+			if releasePlan.ReleasePlanTemplateId == templateId.ValueString() {
+				return &releasePlans[i]
+			}
+		} else if releasePlan.Id == id.ValueString() {
+			return &releasePlans[i]
+		}
+	}
+	return nil
+}
+
+func findMilestone(milestones []releasePlanMilestoneApiModel, name string) *releasePlanMilestoneApiModel {
+	for i, milestone := range milestones {
+		if milestone.Name == name {
+			return &milestones[i]
+		}
+	}
+	return nil
+}
+
+func isActiveMilestone(releasePlan featureReleasePlanApiModel, name string) bool {
+	milestone := findMilestone(releasePlan.Milestones, name)
+	return milestone != nil && releasePlan.ActiveMilestoneId != nil && *releasePlan.ActiveMilestoneId == milestone.Id
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccFeatureReleasePlanTemplate = `
+	resource "unleash_release_plan_template" "rollout" {
+		name = "tf-release-plan-rollout"
+		milestones = [
+			{
+				name = "Internal"
+				strategies = [
+					{
+						name       = "flexibleRollout"
+						parameters = { rollout = "10", stickiness = "default", groupId = "{{featureName}}" }
+					}
+				]
+			},
+			{
+				name = "Everyone"
+				strategies = [
+					{
+						name       = "flexibleRollout"
+						parameters = { rollout = "100", stickiness = "default", groupId = "{{featureName}}" }
+					}
+				]
+			}
+		]
+	}
+`
+
+func TestAccFeatureReleasePlanResource(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-release-plan")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFeatureReleasePlanTemplate + `
+					resource "unleash_feature_release_plan" "rollout" {
+						project          = "default"
+						feature          = "tf-release-plan"
+						environment      = "development"
+						template_id      = unleash_release_plan_template.rollout.id
+						active_milestone = "Internal"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_feature_release_plan.rollout", "id"),
+					resource.TestCheckResourceAttr("unleash_feature_release_plan.rollout", "name", "tf-release-plan-rollout"),
+					resource.TestCheckResourceAttr("unleash_feature_release_plan.rollout", "active_milestone", "Internal"),
+					resource.TestCheckResourceAttr("unleash_feature_release_plan.rollout", "milestones.#", "2"),
+					resource.TestCheckResourceAttrSet("unleash_feature_release_plan.rollout", "milestones.0.started_at"),
+					resource.TestCheckNoResourceAttr("unleash_feature_release_plan.rollout", "milestones.1.started_at"),
+				),
+			},
+			{
+				Config: testAccFeatureReleasePlanTemplate + `
+					resource "unleash_feature_release_plan" "rollout" {
+						project          = "default"
+						feature          = "tf-release-plan"
+						environment      = "development"
+						template_id      = unleash_release_plan_template.rollout.id
+						active_milestone = "Everyone"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_release_plan.rollout", "active_milestone", "Everyone"),
+					resource.TestCheckResourceAttrSet("unleash_feature_release_plan.rollout", "milestones.1.started_at"),
+				),
+			},
+			{
+				ResourceName:            "unleash_feature_release_plan.rollout",
+				ImportState:             true,
+				ImportStateIdPrefix:     "default:tf-release-plan:development:",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"change_request_mode", "change_request_wait", "change_request_timeout"},
+			},
+			{
+				Config: testAccFeatureReleasePlanTemplate + `
+					resource "unleash_feature_release_plan" "rollout" {
+						project          = "default"
+						feature          = "tf-release-plan"
+						environment      = "development"
+						template_id      = unleash_release_plan_template.rollout.id
+						active_milestone = "Half"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Milestone not found"),
+			},
+		},
+	})
+}
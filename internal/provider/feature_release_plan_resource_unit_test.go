@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindReleasePlan(t *testing.T) {
+	releasePlans := []featureReleasePlanApiModel{
+		{Id: "plan-1", ReleasePlanTemplateId: "template-1"},
+		{Id: "plan-2", ReleasePlanTemplateId: "template-2"},
+	}
+
+	found := findReleasePlan(releasePlans, types.StringValue("plan-2"), types.StringValue("template-1"))
+	require.NotNil(t, found)
+	assert.Equal(t, "plan-2", found.Id)
+
+	// plans added through a change request are matched on their template
+	found = findReleasePlan(releasePlans, types.StringNull(), types.StringValue("template-2"))
+	require.NotNil(t, found)
+	assert.Equal(t, "plan-2", found.Id)
+
+	assert.Nil(t, findReleasePlan(releasePlans, types.StringValue("plan-3"), types.StringValue("template-1")))
+}
+
+func TestFeatureReleasePlanHydrateFromApi(t *testing.T) {
+	active := "milestone-2"
+	started := "2025-03-01T10:00:00.000Z"
+	var model featureReleasePlanResourceModel
+
+	model.hydrateFromApi(featureReleasePlanApiModel{
+		Id:                    "plan-1",
+		Name:                  "Gradual rollout",
+		ReleasePlanTemplateId: "template-1",
+		ActiveMilestoneId:     &active,
+		Milestones: []releasePlanMilestoneApiModel{
+			{Id: "milestone-2", Name: "Everyone", SortOrder: 1, StartedAt: &started},
+			{Id: "milestone-1", Name: "Internal", SortOrder: 0, StartedAt: &started},
+		},
+	})
+
+	assert.Equal(t, "Everyone", model.ActiveMilestone.ValueString())
+	require.Len(t, model.Milestones, 2)
+	assert.Equal(t, "Internal", model.Milestones[0].Name.ValueString())
+	assert.Equal(t, started, model.Milestones[1].StartedAt.ValueString())
+
+	model.hydrateFromApi(featureReleasePlanApiModel{
+		Id:         "plan-1",
+		Milestones: []releasePlanMilestoneApiModel{{Id: "milestone-1", Name: "Internal"}},
+	})
+	assert.True(t, model.ActiveMilestone.IsNull())
+	assert.True(t, model.Milestones[0].StartedAt.IsNull())
+}
@@ -0,0 +1,667 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &featureResource{}
+	_ resource.ResourceWithConfigure      = &featureResource{}
+	_ resource.ResourceWithImportState    = &featureResource{}
+	_ resource.ResourceWithValidateConfig = &featureResource{}
+	_ resource.ResourceWithModifyPlan     = &featureResource{}
+)
+
+const (
+	lifecycleStageCompleted = "completed"
+
+	lifecycleStatusKept      = "kept"
+	lifecycleStatusDiscarded = "discarded"
+)
+
+func NewFeatureResource() resource.Resource {
+	return &featureResource{}
+}
+
+type featureResource struct {
+	client *unleash.APIClient
+}
+
+type featureResourceModel struct {
+	Name               types.String             `tfsdk:"name"`
+	Project            types.String             `tfsdk:"project"`
+	Type               types.String             `tfsdk:"type"`
+	Description        types.String             `tfsdk:"description"`
+	ImpressionData     types.Bool               `tfsdk:"impression_data"`
+	Stale              types.Bool               `tfsdk:"stale"`
+	Archived           types.Bool               `tfsdk:"archived"`
+	LifecycleCompleted *lifecycleCompletedModel `tfsdk:"lifecycle_completed"`
+	LifecycleStage     types.String             `tfsdk:"lifecycle_stage"`
+
+	ChangeRequestMode    types.String `tfsdk:"change_request_mode"`
+	ChangeRequestWait    types.Bool   `tfsdk:"change_request_wait"`
+	ChangeRequestTimeout types.String `tfsdk:"change_request_timeout"`
+	ChangeRequestId      types.String `tfsdk:"change_request_id"`
+	ChangeRequestStatus  types.String `tfsdk:"change_request_status"`
+}
+
+type lifecycleCompletedModel struct {
+	Status  types.String `tfsdk:"status"`
+	Variant types.String `tfsdk:"variant"`
+}
+
+type createFeatureApiModel struct {
+	Name           string  `json:"name"`
+	Type           string  `json:"type"`
+	Description    *string `json:"description,omitempty"`
+	ImpressionData bool    `json:"impressionData"`
+}
+
+type updateFeatureApiModel struct {
+	Type           string `json:"type"`
+	Description    string `json:"description"`
+	ImpressionData bool   `json:"impressionData"`
+}
+
+type featureLifecycleStageApiModel struct {
+	Stage          string  `json:"stage"`
+	Status         *string `json:"status,omitempty"`
+	EnteredStageAt string  `json:"enteredStageAt"`
+}
+
+type featureLifecycleCompletedApiModel struct {
+	Status      string  `json:"status"`
+	StatusValue *string `json:"statusValue,omitempty"`
+}
+
+type staleFeaturesApiModel struct {
+	Features []string `json:"features"`
+	Stale    bool     `json:"stale"`
+}
+
+type archivedFeaturesApiModel struct {
+	Features []featureApiModel `json:"features"`
+}
+
+// featureStateApiModel is what the provider knows about a feature flag in Unleash, whether it is archived or not.
+type featureStateApiModel struct {
+	Feature  featureApiModel
+	Archived bool
+	Stages   []featureLifecycleStageApiModel
+}
+
+func (r *featureResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *featureResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature"
+}
+
+func (r *featureResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a feature flag and its lifecycle: whether it is stale, whether it is archived, and whether its lifecycle is completed. " +
+			"Destroying the resource archives the feature flag, and creating it again revives the archived feature flag instead of failing. " +
+			"When the project has change requests enabled, `change_request_mode = \"submit\"` archives the feature flag through a change request in the first protected environment. " +
+			"Strategies and their rollout are left to Unleash.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the feature flag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				Description: "The project the feature flag belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the feature flag: `release`, `experiment`, `operational`, `kill-switch` or `permission`. Defaults to `release`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("release"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("release", "experiment", "operational", "kill-switch", "permission"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the feature flag.",
+				Optional:    true,
+			},
+			"impression_data": schema.BoolAttribute{
+				Description: "Whether SDKs emit impression events for the feature flag. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"stale": schema.BoolAttribute{
+				Description: "Whether the feature flag is marked as stale. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"archived": schema.BoolAttribute{
+				Description: "Whether the feature flag is archived. Setting it back to false revives the feature flag. Archived feature flags can't be changed otherwise. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"lifecycle_completed": schema.SingleNestedAttribute{
+				Description: "Marks the feature flag's lifecycle as completed. Removing it moves the feature flag back to the live stage.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"status": schema.StringAttribute{
+						Description: "Whether the feature was `kept` or `discarded`.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(lifecycleStatusKept, lifecycleStatusDiscarded),
+						},
+					},
+					"variant": schema.StringAttribute{
+						Description: "The variant that was kept. Only valid when `status` is `kept`.",
+						Optional:    true,
+					},
+				},
+			},
+			"lifecycle_stage": schema.StringAttribute{
+				Description: "The lifecycle stage Unleash reports for the feature flag: `initial`, `pre-live`, `live`, `completed` or `archived`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+
+	for name, attribute := range changeRequestResourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (r *featureResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config featureResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newChangeRequestSettings(config.ChangeRequestMode, config.ChangeRequestWait, config.ChangeRequestTimeout, &resp.Diagnostics)
+
+	var status, variant types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lifecycle_completed").AtName("status"), &status)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lifecycle_completed").AtName("variant"), &variant)...)
+	if resp.Diagnostics.HasError() || status.IsNull() || status.IsUnknown() || variant.IsNull() || variant.IsUnknown() {
+		return
+	}
+
+	if status.ValueString() != lifecycleStatusKept {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("lifecycle_completed").AtName("variant"),
+			"Variant requires a kept feature",
+			"The variant records which variant was kept, so it can only be set when status is kept.",
+		)
+	}
+}
+
+// ModifyPlan marks the lifecycle stage as unknown when the plan archives, revives or completes the feature, since
+// Unleash decides which stage that moves it to. It also refuses changes to a feature that stays archived, since
+// Unleash doesn't change archived features. Archiving the feature warns when it would skip change requests the project
+// requires.
+func (r *featureResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state featureResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		if r.client != nil && !state.Archived.ValueBool() {
+			warnAboutDirectWrites(ctx, r.client, state.Project.ValueString(), "", state.ChangeRequestMode, "the archive of this feature flag", &resp.Diagnostics)
+		}
+		return
+	}
+
+	var plan featureResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.client != nil && !state.Archived.ValueBool() && plan.Archived.ValueBool() {
+		warnAboutDirectWrites(ctx, r.client, plan.Project.ValueString(), "", plan.ChangeRequestMode, "the archive of this feature flag", &resp.Diagnostics)
+	}
+
+	if state.Archived.ValueBool() && plan.Archived.ValueBool() && !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.AddError(
+			"Archived feature flags can't be changed",
+			fmt.Sprintf("Feature %s is archived. Set archived to false to revive it before changing it.", plan.Name.ValueString()),
+		)
+		return
+	}
+
+	if !plan.Archived.Equal(state.Archived) || !sameLifecycleCompleted(plan.LifecycleCompleted, state.LifecycleCompleted) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("lifecycle_stage"), types.StringUnknown())...)
+	}
+}
+
+func (r *featureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import feature resource")
+
+	// The unique identifier for a feature is: "<project>:<name>"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format '<project>:<name>'. Example: 'default:new-checkout'",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+
+	tflog.Debug(ctx, "Finished importing feature resource", map[string]any{"success": true})
+}
+
+func (r *featureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create feature resource")
+	var plan featureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, name := plan.Project.ValueString(), plan.Name.ValueString()
+	current, found := r.readFeature(ctx, project, name, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !found:
+		body := createFeatureApiModel{
+			Name:           name,
+			Type:           plan.Type.ValueString(),
+			Description:    plan.Description.ValueStringPointer(),
+			ImpressionData: plan.ImpressionData.ValueBool(),
+		}
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/projects/"+url.PathEscape(project)+"/features", body, nil)
+		if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+		current = featureStateApiModel{Feature: featureApiModel{Name: name, Project: project, Type: body.Type, Description: body.Description, ImpressionData: body.ImpressionData}}
+	case !current.Archived:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Feature already exists",
+			fmt.Sprintf("Feature %s already exists in project %s. Import it with terraform import to manage it.", name, project),
+		)
+		return
+	default:
+		tflog.Info(ctx, fmt.Sprintf("Reviving archived feature %s", name))
+	}
+
+	if !r.reconcile(ctx, &plan, current, nil, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating feature resource", map[string]any{"success": true})
+}
+
+func (r *featureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature resource")
+	var state featureResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultChangeRequestAttributes(&state.ChangeRequestMode, &state.ChangeRequestWait, &state.ChangeRequestTimeout)
+
+	if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+		var changeRequest changeRequestApiModel
+		httpRes, err := fetchChangeRequest(ctx, r.client, state.Project.ValueString(), state.ChangeRequestId.ValueString(), &changeRequest)
+		if isNotFoundResponse(httpRes) {
+			changeRequest.State = changeRequestStateCanceled
+		} else if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+		state.ChangeRequestStatus = types.StringValue(changeRequest.State)
+
+		// until the change request is done, the feature isn't archived yet
+		if isChangeRequestPending(changeRequest.State) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	feature, found := r.readFeature(ctx, state.Project.ValueString(), state.Name.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Feature with id %s not found, removing from state", state.Name.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.hydrateFromApi(feature)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading feature resource", map[string]any{"success": true})
+}
+
+func (r *featureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update feature resource")
+	var plan, state featureResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, found := r.readFeature(ctx, plan.Project.ValueString(), plan.Name.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Feature not found",
+			fmt.Sprintf("Feature %s doesn't exist in project %s, neither active nor archived.", plan.Name.ValueString(), plan.Project.ValueString()),
+		)
+		return
+	}
+
+	if !r.reconcile(ctx, &plan, current, state.LifecycleCompleted, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating feature resource", map[string]any{"success": true})
+}
+
+func (r *featureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete feature resource")
+	var state featureResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Archived.ValueBool() && !r.archive(ctx, &state, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting feature resource", map[string]any{"success": true})
+}
+
+// reconcile moves the feature from its current state to the planned one. Unleash doesn't report which variant was
+// kept, so previouslyCompleted is what was applied before, if anything, and a changed variant completes the feature
+// again. Reviving comes first and archiving last, since an archived feature can't be changed.
+func (r *featureResource) reconcile(ctx context.Context, plan *featureResourceModel, current featureStateApiModel, previouslyCompleted *lifecycleCompletedModel, diagnostics *diag.Diagnostics) bool {
+	project, name := plan.Project.ValueString(), plan.Name.ValueString()
+	plan.ChangeRequestId = types.StringNull()
+	plan.ChangeRequestStatus = types.StringNull()
+
+	if current.Archived && !plan.Archived.ValueBool() {
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/archive/revive/"+url.PathEscape(name), nil, nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return false
+		}
+	}
+
+	currentDescription := ""
+	if current.Feature.Description != nil {
+		currentDescription = *current.Feature.Description
+	}
+	if current.Feature.Type != plan.Type.ValueString() || current.Feature.ImpressionData != plan.ImpressionData.ValueBool() ||
+		currentDescription != plan.Description.ValueString() {
+		body := updateFeatureApiModel{
+			Type:           plan.Type.ValueString(),
+			Description:    plan.Description.ValueString(),
+			ImpressionData: plan.ImpressionData.ValueBool(),
+		}
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, featurePath(project, name), body, nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return false
+		}
+	}
+
+	if current.Feature.Stale != plan.Stale.ValueBool() {
+		body := staleFeaturesApiModel{Features: []string{name}, Stale: plan.Stale.ValueBool()}
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/projects/"+url.PathEscape(project)+"/stale", body, nil)
+		if !ValidateApiResponse(httpRes, 202, diagnostics, err) {
+			return false
+		}
+	}
+
+	completedStatus := current.completedStatus()
+	switch {
+	case plan.LifecycleCompleted != nil:
+		if completedStatus == nil || *completedStatus != plan.LifecycleCompleted.Status.ValueString() ||
+			previouslyCompleted == nil || !previouslyCompleted.Variant.Equal(plan.LifecycleCompleted.Variant) {
+			body := featureLifecycleCompletedApiModel{
+				Status:      plan.LifecycleCompleted.Status.ValueString(),
+				StatusValue: plan.LifecycleCompleted.Variant.ValueStringPointer(),
+			}
+			httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, featureLifecyclePath(project, name)+"/complete", body, nil)
+			if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+				return false
+			}
+		}
+	case completedStatus != nil:
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, featureLifecyclePath(project, name)+"/uncomplete", nil, nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return false
+		}
+	}
+
+	if !current.Archived && plan.Archived.ValueBool() && !r.archive(ctx, plan, diagnostics) {
+		return false
+	}
+
+	stages, ok := r.readStages(ctx, project, name, diagnostics)
+	if !ok {
+		return false
+	}
+	plan.LifecycleStage = types.StringValue(featureStateApiModel{Stages: stages}.stage())
+	return true
+}
+
+// archive archives the feature. In submit mode for projects with change requests enabled, it opens a change request
+// instead and records it on the model. A feature that is already gone counts as archived.
+func (r *featureResource) archive(ctx context.Context, model *featureResourceModel, diagnostics *diag.Diagnostics) bool {
+	project, name := model.Project.ValueString(), model.Name.ValueString()
+
+	settings := newChangeRequestSettings(model.ChangeRequestMode, model.ChangeRequestWait, model.ChangeRequestTimeout, diagnostics)
+	environment, ok := changeRequestEnvironment(ctx, r.client, project, "", settings, diagnostics)
+	if !ok || diagnostics.HasError() {
+		return false
+	}
+
+	if environment != "" {
+		change := changeRequestChangeApiModel{Feature: name, Action: "archiveFeature"}
+		changeRequest := submitChangeRequest(ctx, r.client, project, environment, []changeRequestChangeApiModel{change}, settings, diagnostics)
+		if changeRequest == nil || diagnostics.HasError() {
+			return false
+		}
+
+		model.ChangeRequestId = types.StringValue(strconv.FormatInt(changeRequest.Id, 10))
+		model.ChangeRequestStatus = types.StringValue(changeRequest.State)
+		if changeRequest.State != changeRequestStateApplied {
+			diagnostics.AddWarning(
+				"Archiving waiting for change request",
+				fmt.Sprintf("Feature %s stays active until change request %d is applied.", name, changeRequest.Id),
+			)
+		}
+		return true
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, featurePath(project, name), nil, nil)
+	return isNotFoundResponse(httpRes) || ValidateApiResponse(httpRes, 202, diagnostics, err)
+}
+
+// readFeature reads the feature and its lifecycle. Unleash doesn't return archived features from the feature endpoint,
+// so those are looked up in the archive. found is false when the feature doesn't exist at all.
+func (r *featureResource) readFeature(ctx context.Context, project string, name string, diagnostics *diag.Diagnostics) (featureStateApiModel, bool) {
+	var state featureStateApiModel
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featurePath(project, name), nil, &state.Feature)
+	switch {
+	case isNotFoundResponse(httpRes):
+		archived, ok := r.readArchivedFeature(ctx, project, name, diagnostics)
+		if !ok || archived == nil {
+			return state, false
+		}
+		state.Feature = *archived
+		state.Archived = true
+	case !ValidateApiResponse(httpRes, 200, diagnostics, err):
+		return state, false
+	}
+
+	stages, ok := r.readStages(ctx, project, name, diagnostics)
+	if !ok {
+		return state, false
+	}
+	state.Stages = stages
+	return state, true
+}
+
+func (r *featureResource) readArchivedFeature(ctx context.Context, project string, name string, diagnostics *diag.Diagnostics) (*featureApiModel, bool) {
+	query := url.Values{
+		"query":    {name},
+		"project":  {"IS:" + project},
+		"archived": {"IS:true"},
+	}
+	var archived archivedFeaturesApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, "/api/admin/search/features?"+query.Encode(), nil, &archived)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return nil, false
+	}
+
+	// the query matches substrings, so look for the exact name
+	for i := range archived.Features {
+		if archived.Features[i].Name == name {
+			return &archived.Features[i], true
+		}
+	}
+	return nil, true
+}
+
+func (r *featureResource) readStages(ctx context.Context, project string, name string, diagnostics *diag.Diagnostics) ([]featureLifecycleStageApiModel, bool) {
+	var stages []featureLifecycleStageApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featureLifecyclePath(project, name), nil, &stages)
+	return stages, ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+func featureLifecyclePath(project string, feature string) string {
+	return featurePath(project, feature) + "/lifecycle"
+}
+
+// stage is the stage the feature entered last.
+func (s featureStateApiModel) stage() string {
+	var latest *featureLifecycleStageApiModel
+	for i := range s.Stages {
+		// timestamps are RFC3339 in UTC, so they sort as strings; ties keep the later entry, which is the later stage
+		if latest == nil || s.Stages[i].EnteredStageAt >= latest.EnteredStageAt {
+			latest = &s.Stages[i]
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.Stage
+}
+
+// completedStatus is the status the feature's lifecycle was completed with, or nil if it isn't completed.
+func (s featureStateApiModel) completedStatus() *string {
+	for _, stage := range s.Stages {
+		if stage.Stage == lifecycleStageCompleted {
+			if stage.Status == nil {
+				return new(string)
+			}
+			return stage.Status
+		}
+	}
+	return nil
+}
+
+func (m *featureResourceModel) hydrateFromApi(state featureStateApiModel) {
+	m.Name = types.StringValue(state.Feature.Name)
+	m.Type = types.StringValue(state.Feature.Type)
+	m.Description = types.StringNull()
+	if state.Feature.Description != nil && *state.Feature.Description != "" {
+		m.Description = types.StringValue(*state.Feature.Description)
+	}
+	m.ImpressionData = types.BoolValue(state.Feature.ImpressionData)
+	m.Stale = types.BoolValue(state.Feature.Stale)
+	m.Archived = types.BoolValue(state.Archived)
+	m.LifecycleStage = types.StringValue(state.stage())
+
+	status := state.completedStatus()
+	if status == nil {
+		m.LifecycleCompleted = nil
+		return
+	}
+
+	// Unleash doesn't report the kept variant, so keep the one that was applied
+	variant := types.StringNull()
+	if m.LifecycleCompleted != nil {
+		variant = m.LifecycleCompleted.Variant
+	}
+	m.LifecycleCompleted = &lifecycleCompletedModel{
+		Status:  types.StringValue(*status),
+		Variant: variant,
+	}
+}
+
+func sameLifecycleCompleted(a *lifecycleCompletedModel, b *lifecycleCompletedModel) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Status.Equal(b.Status) && a.Variant.Equal(b.Variant)
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeatureResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_feature" "cleanup" {
+						project     = "default"
+						name        = "tf-lifecycle-feature"
+						description = "Cleaned up by Terraform"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "type", "release"),
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "description", "Cleaned up by Terraform"),
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "stale", "false"),
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "archived", "false"),
+					resource.TestCheckNoResourceAttr("unleash_feature.cleanup", "lifecycle_completed.status"),
+					resource.TestCheckResourceAttrSet("unleash_feature.cleanup", "lifecycle_stage"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_feature" "cleanup" {
+						project     = "default"
+						name        = "tf-lifecycle-feature"
+						type        = "experiment"
+						description = "Cleaned up by Terraform"
+						stale       = true
+						lifecycle_completed = {
+							status = "discarded"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "type", "experiment"),
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "stale", "true"),
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "lifecycle_completed.status", "discarded"),
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "lifecycle_stage", "completed"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_feature" "cleanup" {
+						project     = "default"
+						name        = "tf-lifecycle-feature"
+						type        = "experiment"
+						description = "Cleaned up by Terraform"
+						stale       = true
+						archived    = true
+						lifecycle_completed = {
+							status = "discarded"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "archived", "true"),
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "lifecycle_stage", "archived"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_feature" "cleanup" {
+						project     = "default"
+						name        = "tf-lifecycle-feature"
+						description = "Cleaned up by Terraform"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "stale", "false"),
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "archived", "false"),
+					resource.TestCheckNoResourceAttr("unleash_feature.cleanup", "lifecycle_completed.status"),
+				),
+			},
+			{
+				ResourceName:                         "unleash_feature.cleanup",
+				ImportState:                          true,
+				ImportStateId:                        "default:tf-lifecycle-feature",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// destroying archives the feature, and creating it again revives it
+				Config: `
+					resource "unleash_project" "placeholder" {
+						id   = "tf-lifecycle-placeholder"
+						name = "Placeholder"
+					}
+				`,
+			},
+			{
+				Config: `
+					resource "unleash_feature" "cleanup" {
+						project = "default"
+						name    = "tf-lifecycle-feature"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature.cleanup", "archived", "false"),
+					resource.TestCheckNoResourceAttr("unleash_feature.cleanup", "description"),
+				),
+			},
+		},
+	})
+}
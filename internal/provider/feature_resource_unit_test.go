@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeatureLifecycleStage(t *testing.T) {
+	kept := "kept"
+	feature := featureStateApiModel{Stages: []featureLifecycleStageApiModel{
+		{Stage: "initial", EnteredStageAt: "2026-01-01T10:00:00.000Z"},
+		{Stage: "pre-live", EnteredStageAt: "2026-01-02T10:00:00.000Z"},
+		{Stage: "live", EnteredStageAt: "2026-01-03T10:00:00.000Z"},
+		{Stage: "completed", Status: &kept, EnteredStageAt: "2026-01-03T10:00:00.000Z"},
+	}}
+
+	assert.Equal(t, "completed", feature.stage())
+	require.NotNil(t, feature.completedStatus())
+	assert.Equal(t, "kept", *feature.completedStatus())
+
+	live := featureStateApiModel{Stages: feature.Stages[:3]}
+	assert.Equal(t, "live", live.stage())
+	assert.Nil(t, live.completedStatus())
+	assert.Equal(t, "", featureStateApiModel{}.stage())
+}
+
+func TestFeatureHydrateFromApi(t *testing.T) {
+	kept := "kept"
+	empty := ""
+	model := featureResourceModel{
+		LifecycleCompleted: &lifecycleCompletedModel{Status: types.StringValue("kept"), Variant: types.StringValue("blue")},
+	}
+
+	model.hydrateFromApi(featureStateApiModel{
+		Feature: featureApiModel{Name: "checkout", Type: "experiment", Description: &empty, Stale: true},
+		Stages: []featureLifecycleStageApiModel{
+			{Stage: "completed", Status: &kept, EnteredStageAt: "2026-01-03T10:00:00.000Z"},
+		},
+	})
+	assert.Equal(t, "experiment", model.Type.ValueString())
+	assert.True(t, model.Description.IsNull(), "an empty description is no description")
+	assert.True(t, model.Stale.ValueBool())
+	assert.False(t, model.Archived.ValueBool())
+	assert.Equal(t, "completed", model.LifecycleStage.ValueString())
+	require.NotNil(t, model.LifecycleCompleted)
+	assert.Equal(t, "blue", model.LifecycleCompleted.Variant.ValueString(), "Unleash doesn't report the kept variant")
+
+	model.hydrateFromApi(featureStateApiModel{Feature: featureApiModel{Name: "checkout"}, Archived: true, Stages: []featureLifecycleStageApiModel{
+		{Stage: "archived", EnteredStageAt: "2026-01-03T10:00:00.000Z"},
+	}})
+	assert.True(t, model.Archived.ValueBool())
+	assert.Nil(t, model.LifecycleCompleted)
+}
+
+func TestFeatureReadFindsArchivedFeatures(t *testing.T) {
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/admin/projects/default/features/old-checkout", "/api/admin/projects/default/features/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/api/admin/search/features":
+			assert.Equal(t, "IS:true", r.URL.Query().Get("archived"))
+			assert.Equal(t, "IS:default", r.URL.Query().Get("project"))
+			_, _ = w.Write([]byte(`{"features": [{"name": "old-checkout-2"}, {"name": "old-checkout", "type": "release", "stale": true}], "total": 2}`))
+		case "/api/admin/projects/default/features/old-checkout/lifecycle":
+			_, _ = w.Write([]byte(`[{"stage": "archived", "enteredStageAt": "2026-01-03T10:00:00.000Z"}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	var diags diag.Diagnostics
+	r := &featureResource{client: client}
+	feature, found := r.readFeature(context.Background(), "default", "old-checkout", &diags)
+	require.False(t, diags.HasError(), diags)
+	require.True(t, found)
+	assert.True(t, feature.Archived)
+	assert.True(t, feature.Feature.Stale)
+	assert.Equal(t, "archived", feature.stage())
+
+	_, found = r.readFeature(context.Background(), "default", "missing", &diags)
+	assert.False(t, found)
+}
+
+func TestFeatureReconcileRevivesBeforeChangingAndArchivesLast(t *testing.T) {
+	var calls []string
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path)
+		switch r.URL.Path {
+		case "/api/admin/archive/revive/old-checkout":
+			w.WriteHeader(http.StatusOK)
+		case "/api/admin/projects/default/features/old-checkout":
+			var body updateFeatureApiModel
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, updateFeatureApiModel{Type: "experiment", Description: "Old checkout"}, body)
+			w.WriteHeader(http.StatusOK)
+		case "/api/admin/projects/default/stale":
+			var body staleFeaturesApiModel
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, staleFeaturesApiModel{Features: []string{"old-checkout"}, Stale: false}, body)
+			w.WriteHeader(http.StatusAccepted)
+		case "/api/admin/projects/default/features/old-checkout/lifecycle/complete":
+			var body featureLifecycleCompletedApiModel
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "discarded", body.Status)
+			assert.Nil(t, body.StatusValue)
+			w.WriteHeader(http.StatusOK)
+		case "/api/admin/projects/default/features/old-checkout/lifecycle":
+			_, _ = w.Write([]byte(`[{"stage": "completed", "status": "discarded", "enteredStageAt": "2026-01-03T10:00:00.000Z"}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	plan := featureResourceModel{
+		Project:            types.StringValue("default"),
+		Name:               types.StringValue("old-checkout"),
+		Type:               types.StringValue("experiment"),
+		Description:        types.StringValue("Old checkout"),
+		ImpressionData:     types.BoolValue(false),
+		Stale:              types.BoolValue(false),
+		Archived:           types.BoolValue(false),
+		LifecycleCompleted: &lifecycleCompletedModel{Status: types.StringValue("discarded"), Variant: types.StringNull()},
+	}
+	current := featureStateApiModel{
+		Feature:  featureApiModel{Name: "old-checkout", Type: "release", Stale: true},
+		Archived: true,
+	}
+	var diags diag.Diagnostics
+	r := &featureResource{client: client}
+
+	require.True(t, r.reconcile(context.Background(), &plan, current, nil, &diags), diags)
+	assert.Equal(t, []string{
+		"POST /api/admin/archive/revive/old-checkout",
+		"PUT /api/admin/projects/default/features/old-checkout",
+		"POST /api/admin/projects/default/stale",
+		"POST /api/admin/projects/default/features/old-checkout/lifecycle/complete",
+		"GET /api/admin/projects/default/features/old-checkout/lifecycle",
+	}, calls)
+	assert.Equal(t, "completed", plan.LifecycleStage.ValueString())
+}
+
+func TestFeatureArchiveSubmitsChangeRequest(t *testing.T) {
+	var requests []string
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/admin/projects/default/change-requests/config":
+			_, _ = w.Write([]byte(`[{"environment": "production", "type": "production", "changeRequestEnabled": true, "requiredApprovals": 1}]`))
+		case "POST /api/admin/projects/default/environments/production/change-requests":
+			var changes []changeRequestChangeApiModel
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&changes))
+			assert.Equal(t, []changeRequestChangeApiModel{{Feature: "checkout", Action: "archiveFeature"}}, changes)
+			_, _ = w.Write([]byte(`{"id": 3, "environment": "production", "state": "Draft", "features": [{"name": "checkout", "changes": [{"id": 1, "action": "archiveFeature"}]}], "segments": []}`))
+		case "PUT /api/admin/projects/default/change-requests/3/state":
+			_, _ = w.Write([]byte(`{"id": 3, "environment": "production", "state": "In review"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	feature := &featureResource{client: client}
+
+	model := featureResourceModel{
+		Name:              types.StringValue("checkout"),
+		Project:           types.StringValue("default"),
+		ChangeRequestMode: types.StringValue(changeRequestModeSubmit),
+	}
+	var diags diag.Diagnostics
+	ok := feature.archive(context.Background(), &model, &diags)
+
+	require.True(t, ok)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "3", model.ChangeRequestId.ValueString())
+	assert.Equal(t, changeRequestStateInReview, model.ChangeRequestStatus.ValueString())
+	require.Len(t, diags, 1)
+	assert.Equal(t, "Archiving waiting for change request", diags[0].Summary())
+	assert.Len(t, requests, 3)
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Activation strategies attached to features, release plan milestones and anything else that rolls a feature out.
+type featureStrategyModel struct {
+	Name        types.String           `tfsdk:"name"`
+	Title       types.String           `tfsdk:"title"`
+	Parameters  types.Map              `tfsdk:"parameters"`
+	Constraints []constraintModel      `tfsdk:"constraints"`
+	Variants    []strategyVariantModel `tfsdk:"variants"`
+	Segments    types.List             `tfsdk:"segments"`
+}
+
+type strategyVariantModel struct {
+	Name       types.String         `tfsdk:"name"`
+	Weight     types.Int64          `tfsdk:"weight"`
+	WeightType types.String         `tfsdk:"weight_type"`
+	Stickiness types.String         `tfsdk:"stickiness"`
+	Payload    *variantPayloadModel `tfsdk:"payload"`
+}
+
+type variantPayloadModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type featureStrategyApiModel struct {
+	Id          string                    `json:"id,omitempty"`
+	Name        string                    `json:"name"`
+	Title       *string                   `json:"title,omitempty"`
+	Disabled    *bool                     `json:"disabled,omitempty"`
+	SortOrder   *int64                    `json:"sortOrder,omitempty"`
+	Parameters  map[string]string         `json:"parameters"`
+	Constraints []constraintApiModel      `json:"constraints"`
+	Variants    []strategyVariantApiModel `json:"variants"`
+	Segments    []int64                   `json:"segments"`
+}
+
+type strategyVariantApiModel struct {
+	Name       string                  `json:"name"`
+	Weight     int64                   `json:"weight"`
+	WeightType string                  `json:"weightType"`
+	Stickiness string                  `json:"stickiness"`
+	Payload    *variantPayloadApiModel `json:"payload,omitempty"`
+}
+
+type variantPayloadApiModel struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func featureStrategiesResourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Description: "The name of the strategy, for example `flexibleRollout` or the name of a custom strategy.",
+					Required:    true,
+				},
+				"title": schema.StringAttribute{
+					Description: "A descriptive title for the strategy.",
+					Optional:    true,
+				},
+				"parameters": schema.MapAttribute{
+					Description: "The strategy parameters, for example `rollout`, `stickiness` and `groupId` for `flexibleRollout`.",
+					Optional:    true,
+					Computed:    true,
+					ElementType: types.StringType,
+					Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+				},
+				"constraints": constraintsResourceAttribute("The constraints that must match for the strategy to apply."),
+				"variants": schema.ListNestedAttribute{
+					Description: "The variants handed out when the strategy applies.",
+					Optional:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Description: "The name of the variant.",
+								Required:    true,
+							},
+							"weight": schema.Int64Attribute{
+								Description: "The weight of the variant, out of 1000. Unleash spreads the remaining weight across variants with a `variable` weight type.",
+								Optional:    true,
+								Computed:    true,
+							},
+							"weight_type": schema.StringAttribute{
+								Description: "Either `variable` or `fix`. Defaults to `variable`.",
+								Optional:    true,
+								Computed:    true,
+								Default:     stringdefault.StaticString("variable"),
+								Validators: []validator.String{
+									stringvalidator.OneOf("variable", "fix"),
+								},
+							},
+							"stickiness": schema.StringAttribute{
+								Description: "The context field used to assign the variant. Defaults to `default`.",
+								Optional:    true,
+								Computed:    true,
+								Default:     stringdefault.StaticString("default"),
+							},
+							"payload": schema.SingleNestedAttribute{
+								Description: "Extra data returned with the variant.",
+								Optional:    true,
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "The type of the payload, one of `string`, `json`, `csv` or `number`.",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("string", "json", "csv", "number"),
+										},
+									},
+									"value": schema.StringAttribute{
+										Description: "The payload value.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+				"segments": schema.ListAttribute{
+					Description: "The ids of the segments the strategy applies to.",
+					Optional:    true,
+					Computed:    true,
+					ElementType: types.StringType,
+					Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				},
+			},
+		},
+	}
+}
+
+func featureStrategiesDataSourceAttribute(description string) datasourceschema.ListNestedAttribute {
+	return datasourceschema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: datasourceschema.NestedAttributeObject{
+			Attributes: map[string]datasourceschema.Attribute{
+				"name": datasourceschema.StringAttribute{
+					Description: "The name of the strategy.",
+					Computed:    true,
+				},
+				"title": datasourceschema.StringAttribute{
+					Description: "A descriptive title for the strategy.",
+					Computed:    true,
+				},
+				"parameters": datasourceschema.MapAttribute{
+					Description: "The strategy parameters.",
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+				"constraints": constraintsDataSourceAttribute("The constraints that must match for the strategy to apply."),
+				"variants": datasourceschema.ListNestedAttribute{
+					Description: "The variants handed out when the strategy applies.",
+					Computed:    true,
+					NestedObject: datasourceschema.NestedAttributeObject{
+						Attributes: map[string]datasourceschema.Attribute{
+							"name": datasourceschema.StringAttribute{
+								Description: "The name of the variant.",
+								Computed:    true,
+							},
+							"weight": datasourceschema.Int64Attribute{
+								Description: "The weight of the variant, out of 1000.",
+								Computed:    true,
+							},
+							"weight_type": datasourceschema.StringAttribute{
+								Description: "Either `variable` or `fix`.",
+								Computed:    true,
+							},
+							"stickiness": datasourceschema.StringAttribute{
+								Description: "The context field used to assign the variant.",
+								Computed:    true,
+							},
+							"payload": datasourceschema.SingleNestedAttribute{
+								Description: "Extra data returned with the variant.",
+								Computed:    true,
+								Attributes: map[string]datasourceschema.Attribute{
+									"type": datasourceschema.StringAttribute{
+										Description: "The type of the payload.",
+										Computed:    true,
+									},
+									"value": datasourceschema.StringAttribute{
+										Description: "The payload value.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+				"segments": datasourceschema.ListAttribute{
+					Description: "The ids of the segments the strategy applies to.",
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+			},
+		},
+	}
+}
+
+func expandFeatureStrategies(ctx context.Context, models []featureStrategyModel, diagnostics *diag.Diagnostics) []featureStrategyApiModel {
+	strategies := make([]featureStrategyApiModel, 0, len(models))
+
+	for i, model := range models {
+		sortOrder := int64(i)
+		strategy := featureStrategyApiModel{
+			Name:        model.Name.ValueString(),
+			SortOrder:   &sortOrder,
+			Parameters:  map[string]string{},
+			Constraints: expandConstraints(ctx, model.Constraints, diagnostics),
+			Variants:    []strategyVariantApiModel{},
+			Segments:    []int64{},
+		}
+
+		if !model.Title.IsNull() && !model.Title.IsUnknown() {
+			strategy.Title = model.Title.ValueStringPointer()
+		}
+
+		if !model.Parameters.IsNull() && !model.Parameters.IsUnknown() {
+			diagnostics.Append(model.Parameters.ElementsAs(ctx, &strategy.Parameters, false)...)
+		}
+
+		if !model.Segments.IsNull() && !model.Segments.IsUnknown() {
+			var segments []string
+			diagnostics.Append(model.Segments.ElementsAs(ctx, &segments, false)...)
+			for _, segment := range segments {
+				id, err := strconv.ParseInt(segment, 10, 64)
+				if err != nil {
+					diagnostics.AddError("Invalid segment id", fmt.Sprintf("Segment id %q of strategy %s is not a number.", segment, strategy.Name))
+					continue
+				}
+				strategy.Segments = append(strategy.Segments, id)
+			}
+		}
+
+		for _, variant := range model.Variants {
+			apiVariant := strategyVariantApiModel{
+				Name:       variant.Name.ValueString(),
+				Weight:     variant.Weight.ValueInt64(),
+				WeightType: variant.WeightType.ValueString(),
+				Stickiness: variant.Stickiness.ValueString(),
+			}
+			if variant.Payload != nil {
+				apiVariant.Payload = &variantPayloadApiModel{
+					Type:  variant.Payload.Type.ValueString(),
+					Value: variant.Payload.Value.ValueString(),
+				}
+			}
+			strategy.Variants = append(strategy.Variants, apiVariant)
+		}
+
+		if diagnostics.HasError() {
+			return nil
+		}
+
+		strategies = append(strategies, strategy)
+	}
+
+	return strategies
+}
+
+// flattenFeatureStrategies keeps empty lists in state the same way they were configured, like flattenConstraints.
+func flattenFeatureStrategies(ctx context.Context, current []featureStrategyModel, strategies []featureStrategyApiModel, diagnostics *diag.Diagnostics) []featureStrategyModel {
+	if len(strategies) == 0 {
+		if current == nil {
+			return nil
+		}
+		return []featureStrategyModel{}
+	}
+
+	models := make([]featureStrategyModel, 0, len(strategies))
+
+	for i, strategy := range strategies {
+		var existing featureStrategyModel
+		if i < len(current) {
+			existing = current[i]
+		}
+
+		parameters := strategy.Parameters
+		if parameters == nil {
+			parameters = map[string]string{}
+		}
+		parametersMap, diags := types.MapValueFrom(ctx, types.StringType, parameters)
+		diagnostics.Append(diags...)
+
+		segments := make([]string, 0, len(strategy.Segments))
+		for _, segment := range strategy.Segments {
+			segments = append(segments, strconv.FormatInt(segment, 10))
+		}
+		segmentsList, diags := types.ListValueFrom(ctx, types.StringType, segments)
+		diagnostics.Append(diags...)
+
+		model := featureStrategyModel{
+			Name:        types.StringValue(strategy.Name),
+			Title:       types.StringNull(),
+			Parameters:  parametersMap,
+			Constraints: flattenConstraints(ctx, existing.Constraints, strategy.Constraints, diagnostics),
+			Segments:    segmentsList,
+		}
+
+		if strategy.Title != nil && *strategy.Title != "" {
+			model.Title = types.StringValue(*strategy.Title)
+		}
+
+		if len(strategy.Variants) > 0 {
+			model.Variants = make([]strategyVariantModel, 0, len(strategy.Variants))
+			for _, variant := range strategy.Variants {
+				variantModel := strategyVariantModel{
+					Name:       types.StringValue(variant.Name),
+					Weight:     types.Int64Value(variant.Weight),
+					WeightType: types.StringValue(variant.WeightType),
+					Stickiness: types.StringValue(variant.Stickiness),
+				}
+				if variant.Payload != nil {
+					variantModel.Payload = &variantPayloadModel{
+						Type:  types.StringValue(variant.Payload.Type),
+						Value: types.StringValue(variant.Payload.Value),
+					}
+				}
+				model.Variants = append(model.Variants, variantModel)
+			}
+		} else if existing.Variants != nil {
+			model.Variants = []strategyVariantModel{}
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
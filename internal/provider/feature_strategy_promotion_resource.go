@@ -0,0 +1,595 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	strategyPromotionModeReplace = "replace"
+	strategyPromotionModeAppend  = "append"
+)
+
+var (
+	_ resource.Resource                   = &featureStrategyPromotionResource{}
+	_ resource.ResourceWithConfigure      = &featureStrategyPromotionResource{}
+	_ resource.ResourceWithImportState    = &featureStrategyPromotionResource{}
+	_ resource.ResourceWithValidateConfig = &featureStrategyPromotionResource{}
+	_ resource.ResourceWithModifyPlan     = &featureStrategyPromotionResource{}
+)
+
+var strategyChangeAttrTypes = map[string]attr.Type{
+	"action": types.StringType,
+	"name":   types.StringType,
+	"title":  types.StringType,
+}
+
+func NewFeatureStrategyPromotionResource() resource.Resource {
+	return &featureStrategyPromotionResource{}
+}
+
+type featureStrategyPromotionResource struct {
+	client *unleash.APIClient
+}
+
+type featureStrategyPromotionResourceModel struct {
+	Project           types.String `tfsdk:"project"`
+	Feature           types.String `tfsdk:"feature"`
+	SourceEnvironment types.String `tfsdk:"source_environment"`
+	TargetEnvironment types.String `tfsdk:"target_environment"`
+	Mode              types.String `tfsdk:"mode"`
+	InSync            types.Bool   `tfsdk:"in_sync"`
+	Diff              types.List   `tfsdk:"diff"`
+
+	ChangeRequestMode    types.String `tfsdk:"change_request_mode"`
+	ChangeRequestWait    types.Bool   `tfsdk:"change_request_wait"`
+	ChangeRequestTimeout types.String `tfsdk:"change_request_timeout"`
+	ChangeRequestId      types.String `tfsdk:"change_request_id"`
+	ChangeRequestStatus  types.String `tfsdk:"change_request_status"`
+}
+
+// strategyChange is a strategy the promotion adds to or removes from the target environment.
+type strategyChange struct {
+	Action   string
+	Strategy featureStrategyApiModel
+}
+
+func (r *featureStrategyPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *featureStrategyPromotionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_strategy_promotion"
+}
+
+func (r *featureStrategyPromotionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Promotes the strategies of a feature from one environment to another, with their constraints, segments and variants. Later changes to either environment show up as a diff and are promoted again on the next apply. When another resource, such as `unleash_feature_manifest` or another promotion, changes the strategies of either environment, make the promotion depend on it: the diff is then worked out during the apply. Destroying the resource leaves the target environment as it is.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project the feature belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"feature": schema.StringAttribute{
+				Description: "The name of the feature.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_environment": schema.StringAttribute{
+				Description: "The environment to copy the strategies from. It must be enabled in the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_environment": schema.StringAttribute{
+				Description: "The environment to copy the strategies to. It must be enabled in the project.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				Description: "`replace` makes the strategies of the target environment match the source, removing any the source doesn't have. `append` only adds the source strategies the target is missing. Defaults to `replace`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(strategyPromotionModeReplace),
+				Validators: []validator.String{
+					stringvalidator.OneOf(strategyPromotionModeReplace, strategyPromotionModeAppend),
+				},
+			},
+			"in_sync": schema.BoolAttribute{
+				Description: "Whether the target environment has the strategies of the source environment.",
+				Computed:    true,
+			},
+			"diff": schema.ListNestedAttribute{
+				Description: "The strategies the promotion adds to or removes from the target environment. While planning this is what the apply will change.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Description: "Either `add` or `remove`.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the strategy.",
+							Computed:    true,
+						},
+						"title": schema.StringAttribute{
+							Description: "The title of the strategy, if it has one.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, attribute := range changeRequestResourceAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+func (r *featureStrategyPromotionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config featureStrategyPromotionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.SourceEnvironment.IsUnknown() && !config.TargetEnvironment.IsUnknown() && !config.SourceEnvironment.IsNull() &&
+		config.SourceEnvironment.ValueString() == config.TargetEnvironment.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target_environment"),
+			"Invalid strategy promotion",
+			fmt.Sprintf("Strategies can't be promoted from %s to itself.", config.SourceEnvironment.ValueString()),
+		)
+	}
+
+	newChangeRequestSettings(config.ChangeRequestMode, config.ChangeRequestWait, config.ChangeRequestTimeout, &resp.Diagnostics)
+}
+
+// ModifyPlan works out which strategies the apply will add and remove, so they show up in the plan. When a resource
+// planned before this one changes the strategies of either environment, the diff is left unknown and worked out during
+// the apply. It also warns when the write would skip change requests the target environment requires.
+func (r *featureStrategyPromotionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan featureStrategyPromotionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Project.IsUnknown() || plan.Feature.IsUnknown() || plan.SourceEnvironment.IsUnknown() || plan.TargetEnvironment.IsUnknown() || plan.Mode.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state featureStrategyPromotionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// the promotion was submitted as a change request, promoting again would open another one
+		if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) && plan.Mode.Equal(state.Mode) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("in_sync"), state.InSync)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("diff"), state.Diff)...)
+			return
+		}
+	}
+
+	for _, attribute := range []string{"source_environment", "target_environment"} {
+		environment := plan.SourceEnvironment.ValueString()
+		if attribute == "target_environment" {
+			environment = plan.TargetEnvironment.ValueString()
+		}
+
+		enabled, ok := projectEnvironmentIsEnabled(ctx, r.client, plan.Project.ValueString(), environment, &resp.Diagnostics)
+		if !ok {
+			return
+		}
+		if !enabled {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Environment not enabled",
+				fmt.Sprintf("Environment %s is not enabled in project %s.", environment, plan.Project.ValueString()),
+			)
+			return
+		}
+	}
+
+	project, feature := plan.Project.ValueString(), plan.Feature.ValueString()
+	if hasPendingStrategyChanges(r.client, project, feature, plan.SourceEnvironment.ValueString()) ||
+		hasPendingStrategyChanges(r.client, project, feature, plan.TargetEnvironment.ValueString()) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("in_sync"), types.BoolValue(true))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("diff"), types.ListUnknown(types.ObjectType{AttrTypes: strategyChangeAttrTypes}))...)
+		planStrategyChanges(r.client, project, feature, plan.TargetEnvironment.ValueString())
+		warnAboutDirectWrites(ctx, r.client, project, plan.TargetEnvironment.ValueString(), plan.ChangeRequestMode, "the promoted strategies", &resp.Diagnostics)
+		return
+	}
+
+	changes, httpRes := r.changes(ctx, plan, &resp.Diagnostics)
+	if isNotFoundResponse(httpRes) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("feature"),
+			"Feature not found",
+			fmt.Sprintf("Feature %s does not exist in project %s.", plan.Feature.ValueString(), plan.Project.ValueString()),
+		)
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("in_sync"), types.BoolValue(true))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("diff"), strategyChangesList(changes, &resp.Diagnostics))...)
+
+	if len(changes) > 0 {
+		planStrategyChanges(r.client, project, feature, plan.TargetEnvironment.ValueString())
+		warnAboutDirectWrites(ctx, r.client, plan.Project.ValueString(), plan.TargetEnvironment.ValueString(), plan.ChangeRequestMode, "the promoted strategies", &resp.Diagnostics)
+	}
+}
+
+func (r *featureStrategyPromotionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import feature strategy promotion resource")
+
+	// The unique identifier for a strategy promotion is: "<project>:<feature>:<source environment>:<target environment>"
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected format '<project>:<feature>:<source environment>:<target environment>'. Example: 'default:new-checkout:staging:production'",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("source_environment"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_environment"), parts[3])...)
+
+	tflog.Debug(ctx, "Finished importing feature strategy promotion resource", map[string]any{"success": true})
+}
+
+func (r *featureStrategyPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create feature strategy promotion resource")
+	var plan featureStrategyPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ChangeRequestId = types.StringNull()
+	plan.ChangeRequestStatus = types.StringNull()
+
+	if !r.promote(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating feature strategy promotion resource", map[string]any{"success": true})
+}
+
+func (r *featureStrategyPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature strategy promotion resource")
+	var state featureStrategyPromotionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defaultChangeRequestAttributes(&state.ChangeRequestMode, &state.ChangeRequestWait, &state.ChangeRequestTimeout)
+	if state.Mode.IsNull() {
+		state.Mode = types.StringValue(strategyPromotionModeReplace)
+	}
+
+	if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) {
+		var changeRequest changeRequestApiModel
+		httpRes, err := fetchChangeRequest(ctx, r.client, state.Project.ValueString(), state.ChangeRequestId.ValueString(), &changeRequest)
+		if isNotFoundResponse(httpRes) {
+			changeRequest.State = changeRequestStateCanceled
+		} else if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+		state.ChangeRequestStatus = types.StringValue(changeRequest.State)
+
+		// until the change request is done, the target environment doesn't have the promoted strategies yet
+		if isChangeRequestPending(changeRequest.State) {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	}
+
+	var diagnostics diag.Diagnostics
+	changes, httpRes := r.changes(ctx, state, &diagnostics)
+	if isNotFoundResponse(httpRes) {
+		tflog.Warn(ctx, fmt.Sprintf("Feature %s not found, removing from state", state.Feature.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(diagnostics...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.InSync = types.BoolValue(len(changes) == 0)
+	state.Diff = strategyChangesList(changes, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading feature strategy promotion resource", map[string]any{"success": true})
+}
+
+func (r *featureStrategyPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update feature strategy promotion resource")
+	var plan featureStrategyPromotionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state featureStrategyPromotionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// a change request opened earlier stays in state until something new is promoted
+	plan.ChangeRequestId = state.ChangeRequestId
+	plan.ChangeRequestStatus = state.ChangeRequestStatus
+
+	// only the change request settings changed while the last promotion is still under review
+	if !state.ChangeRequestId.IsNull() && isChangeRequestPending(state.ChangeRequestStatus.ValueString()) && plan.Mode.Equal(state.Mode) {
+		plan.InSync = state.InSync
+		plan.Diff = state.Diff
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	if !r.promote(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating feature strategy promotion resource", map[string]any{"success": true})
+}
+
+func (r *featureStrategyPromotionResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete feature strategy promotion resource")
+
+	// the promoted strategies belong to the target environment now, so they are left in place
+	resp.State.RemoveResource(ctx)
+
+	tflog.Debug(ctx, "Finished deleting feature strategy promotion resource", map[string]any{"success": true})
+}
+
+// promote adds and removes strategies in the target environment until it matches the source. In submit mode for
+// environments with change requests enabled, the changes are sent as one change request instead.
+func (r *featureStrategyPromotionResource) promote(ctx context.Context, plan *featureStrategyPromotionResourceModel, diagnostics *diag.Diagnostics) bool {
+	changes, _ := r.changes(ctx, *plan, diagnostics)
+	if diagnostics.HasError() {
+		return false
+	}
+
+	diff := strategyChangesList(changes, diagnostics)
+	if !plan.Diff.IsUnknown() && !plan.Diff.Equal(diff) {
+		diagnostics.AddError(
+			"Strategies changed since plan",
+			fmt.Sprintf("The strategies of feature %s changed after the plan was made. Run terraform plan again to review the new changes. "+
+				"If another resource in this configuration changes them, make this resource depend on it so the promotion waits for it.", plan.Feature.ValueString()),
+		)
+		return false
+	}
+	plan.Diff = diff
+	plan.InSync = types.BoolValue(true)
+
+	if len(changes) == 0 {
+		return true
+	}
+
+	settings := newChangeRequestSettings(plan.ChangeRequestMode, plan.ChangeRequestWait, plan.ChangeRequestTimeout, diagnostics)
+	environment, ok := changeRequestEnvironment(ctx, r.client, plan.Project.ValueString(), plan.TargetEnvironment.ValueString(), settings, diagnostics)
+	if !ok || diagnostics.HasError() {
+		return false
+	}
+
+	if environment != "" {
+		requests := make([]changeRequestChangeApiModel, 0, len(changes))
+		for _, change := range changes {
+			if change.Action == "remove" {
+				requests = append(requests, changeRequestChangeApiModel{
+					Feature: plan.Feature.ValueString(),
+					Action:  "deleteStrategy",
+					Payload: map[string]string{"id": change.Strategy.Id},
+				})
+			} else {
+				requests = append(requests, changeRequestChangeApiModel{
+					Feature: plan.Feature.ValueString(),
+					Action:  "addStrategy",
+					Payload: change.Strategy,
+				})
+			}
+		}
+
+		changeRequest := submitChangeRequest(ctx, r.client, plan.Project.ValueString(), environment, requests, settings, diagnostics)
+		if changeRequest == nil || diagnostics.HasError() {
+			return false
+		}
+
+		plan.ChangeRequestId = types.StringValue(strconv.FormatInt(changeRequest.Id, 10))
+		plan.ChangeRequestStatus = types.StringValue(changeRequest.State)
+		return true
+	}
+
+	plan.ChangeRequestId = types.StringNull()
+	plan.ChangeRequestStatus = types.StringNull()
+
+	strategiesPath := featureEnvironmentPath(plan.Project.ValueString(), plan.Feature.ValueString(), plan.TargetEnvironment.ValueString()) + "/strategies"
+	for _, change := range changes {
+		var httpRes *http.Response
+		var err error
+		if change.Action == "remove" {
+			httpRes, err = adminApiRequest(ctx, r.client, http.MethodDelete, strategiesPath+"/"+url.PathEscape(change.Strategy.Id), nil, nil)
+		} else {
+			httpRes, err = adminApiRequest(ctx, r.client, http.MethodPost, strategiesPath, change.Strategy, nil)
+		}
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// changes reads the strategies of both environments and works out what promoting them would change. The response of
+// a failed read is returned so callers can tell a missing feature apart from other errors.
+func (r *featureStrategyPromotionResource) changes(ctx context.Context, model featureStrategyPromotionResourceModel, diagnostics *diag.Diagnostics) ([]strategyChange, *http.Response) {
+	var source, target []featureStrategyApiModel
+
+	sourcePath := featureEnvironmentPath(model.Project.ValueString(), model.Feature.ValueString(), model.SourceEnvironment.ValueString()) + "/strategies"
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, sourcePath, nil, &source)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return nil, httpRes
+	}
+
+	targetPath := featureEnvironmentPath(model.Project.ValueString(), model.Feature.ValueString(), model.TargetEnvironment.ValueString()) + "/strategies"
+	httpRes, err = adminApiRequest(ctx, r.client, http.MethodGet, targetPath, nil, &target)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return nil, httpRes
+	}
+
+	return strategyPromotionChanges(source, target, model.Mode.ValueString()), httpRes
+}
+
+// strategyPromotionChanges compares strategies by what they do, ignoring ids and sort order. Removals come first so a
+// replaced strategy is never evaluated twice.
+func strategyPromotionChanges(source []featureStrategyApiModel, target []featureStrategyApiModel, mode string) []strategyChange {
+	changes := []strategyChange{}
+
+	unmatched := slices.Clone(target)
+	additions := []strategyChange{}
+	for _, strategy := range source {
+		index := slices.IndexFunc(unmatched, func(existing featureStrategyApiModel) bool {
+			return sameFeatureStrategy(existing, strategy)
+		})
+		if index >= 0 {
+			unmatched = slices.Delete(unmatched, index, index+1)
+			continue
+		}
+
+		strategy.Id = ""
+		additions = append(additions, strategyChange{Action: "add", Strategy: strategy})
+	}
+
+	if mode == strategyPromotionModeReplace {
+		for _, strategy := range unmatched {
+			changes = append(changes, strategyChange{Action: "remove", Strategy: strategy})
+		}
+	}
+
+	return append(changes, additions...)
+}
+
+func sameFeatureStrategy(a featureStrategyApiModel, b featureStrategyApiModel) bool {
+	return reflect.DeepEqual(normalizeFeatureStrategy(a), normalizeFeatureStrategy(b))
+}
+
+// normalizeFeatureStrategy drops what differs between copies of the same strategy in different environments.
+func normalizeFeatureStrategy(strategy featureStrategyApiModel) featureStrategyApiModel {
+	normalized := featureStrategyApiModel{
+		Name:        strategy.Name,
+		Parameters:  map[string]string{},
+		Constraints: []constraintApiModel{},
+		Variants:    []strategyVariantApiModel{},
+		Segments:    slices.Sorted(slices.Values(strategy.Segments)),
+	}
+
+	if strategy.Title != nil && *strategy.Title != "" {
+		normalized.Title = strategy.Title
+	}
+	if strategy.Disabled != nil && *strategy.Disabled {
+		normalized.Disabled = strategy.Disabled
+	}
+	for key, value := range strategy.Parameters {
+		normalized.Parameters[key] = value
+	}
+	for _, constraint := range strategy.Constraints {
+		if constraint.Values == nil {
+			constraint.Values = []string{}
+		}
+		if constraint.Value != nil && *constraint.Value == "" {
+			constraint.Value = nil
+		}
+		normalized.Constraints = append(normalized.Constraints, constraint)
+	}
+	normalized.Variants = append(normalized.Variants, strategy.Variants...)
+	if normalized.Segments == nil {
+		normalized.Segments = []int64{}
+	}
+
+	return normalized
+}
+
+func strategyChangesList(changes []strategyChange, diagnostics *diag.Diagnostics) types.List {
+	values := make([]attr.Value, 0, len(changes))
+	for _, change := range changes {
+		title := types.StringNull()
+		if change.Strategy.Title != nil && *change.Strategy.Title != "" {
+			title = types.StringValue(*change.Strategy.Title)
+		}
+
+		value, diags := types.ObjectValue(strategyChangeAttrTypes, map[string]attr.Value{
+			"action": types.StringValue(change.Action),
+			"name":   types.StringValue(change.Strategy.Name),
+			"title":  title,
+		})
+		diagnostics.Append(diags...)
+		values = append(values, value)
+	}
+
+	list, diags := types.ListValue(types.ObjectType{AttrTypes: strategyChangeAttrTypes}, values)
+	diagnostics.Append(diags...)
+	return list
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// testAccAddFeatureStrategy adds a strategy to a feature environment directly through the API.
+func testAccAddFeatureStrategy(t *testing.T, project string, feature string, environment string, strategy featureStrategyApiModel) {
+	t.Helper()
+
+	strategiesPath := featureEnvironmentPath(project, feature, environment) + "/strategies"
+	if _, err := adminApiRequest(context.Background(), testAccClient(t), http.MethodPost, strategiesPath, strategy, nil); err != nil {
+		t.Fatalf("Failed to add strategy %s to feature %s: %v", strategy.Name, feature, err)
+	}
+}
+
+func TestAccFeatureStrategyPromotionResource(t *testing.T) {
+	config := `
+		resource "unleash_feature_strategy_promotion" "production" {
+			project            = "default"
+			feature            = "tf-promotion"
+			source_environment = "development"
+			target_environment = "production"
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-promotion")
+			testAccAddFeatureStrategy(t, "default", "tf-promotion", "development", featureStrategyApiModel{
+				Name:       "flexibleRollout",
+				Parameters: map[string]string{"rollout": "50", "stickiness": "default", "groupId": "tf-promotion"},
+				Constraints: []constraintApiModel{
+					{ContextName: "userId", Operator: "IN", Values: []string{"1", "2"}},
+				},
+			})
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_strategy_promotion.production", "mode", "replace"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy_promotion.production", "in_sync", "true"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy_promotion.production", "diff.#", "1"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy_promotion.production", "diff.0.action", "add"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy_promotion.production", "diff.0.name", "flexibleRollout"),
+				),
+			},
+			{
+				PreConfig: func() {
+					title := "Beta testers"
+					testAccAddFeatureStrategy(t, "default", "tf-promotion", "development", featureStrategyApiModel{
+						Name:       "default",
+						Title:      &title,
+						Parameters: map[string]string{},
+					})
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_strategy_promotion.production", "in_sync", "true"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy_promotion.production", "diff.#", "1"),
+					resource.TestCheckResourceAttr("unleash_feature_strategy_promotion.production", "diff.0.title", "Beta testers"),
+				),
+			},
+			{
+				ResourceName:                         "unleash_feature_strategy_promotion.production",
+				ImportState:                          true,
+				ImportStateId:                        "default:tf-promotion:development:production",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"diff", "change_request_mode", "change_request_wait", "change_request_timeout"},
+				ImportStateVerifyIdentifierAttribute: "feature",
+			},
+			{
+				Config: `
+					resource "unleash_feature_strategy_promotion" "invalid" {
+						project            = "default"
+						feature            = "tf-promotion"
+						source_environment = "development"
+						target_environment = "development"
+					}
+				`,
+				ExpectError: regexp.MustCompile("Invalid strategy promotion"),
+			},
+		},
+	})
+}
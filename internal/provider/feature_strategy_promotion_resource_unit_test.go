@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrategyPromotionChanges(t *testing.T) {
+	title := "Internal users"
+	internal := featureStrategyApiModel{
+		Id:         "source-1",
+		Name:       "flexibleRollout",
+		Title:      &title,
+		Parameters: map[string]string{"rollout": "100"},
+		Constraints: []constraintApiModel{
+			{ContextName: "userId", Operator: "IN", Values: []string{"1"}},
+		},
+		Segments: []int64{2, 1},
+	}
+	everyone := featureStrategyApiModel{Id: "source-2", Name: "default"}
+
+	// the same strategy in the target, as Unleash returns it
+	copied := internal
+	copied.Id = "target-1"
+	copied.Segments = []int64{1, 2}
+	stale := featureStrategyApiModel{Id: "target-2", Name: "userWithId", Parameters: map[string]string{"userIds": "1"}}
+
+	changes := strategyPromotionChanges([]featureStrategyApiModel{internal, everyone}, []featureStrategyApiModel{copied, stale}, strategyPromotionModeReplace)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "remove", changes[0].Action)
+	assert.Equal(t, "target-2", changes[0].Strategy.Id)
+	assert.Equal(t, "add", changes[1].Action)
+	assert.Equal(t, "default", changes[1].Strategy.Name)
+	assert.Empty(t, changes[1].Strategy.Id, "added strategies get a new id in the target")
+
+	changes = strategyPromotionChanges([]featureStrategyApiModel{internal, everyone}, []featureStrategyApiModel{copied, stale}, strategyPromotionModeAppend)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "add", changes[0].Action)
+
+	changes = strategyPromotionChanges([]featureStrategyApiModel{internal}, []featureStrategyApiModel{copied}, strategyPromotionModeReplace)
+	assert.Empty(t, changes)
+}
+
+func TestStrategyPromotionChangesCopiesDuplicates(t *testing.T) {
+	everyone := featureStrategyApiModel{Name: "default"}
+
+	changes := strategyPromotionChanges([]featureStrategyApiModel{everyone, everyone}, []featureStrategyApiModel{everyone}, strategyPromotionModeReplace)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "add", changes[0].Action)
+}
+
+func TestSameFeatureStrategyIgnoresEmptyValues(t *testing.T) {
+	empty := ""
+	disabled := false
+
+	assert.True(t, sameFeatureStrategy(
+		featureStrategyApiModel{Name: "default", Title: &empty, Disabled: &disabled},
+		featureStrategyApiModel{Name: "default", Parameters: map[string]string{}, Constraints: []constraintApiModel{}, Segments: []int64{}},
+	))
+	assert.False(t, sameFeatureStrategy(
+		featureStrategyApiModel{Name: "default"},
+		featureStrategyApiModel{Name: "default", Variants: []strategyVariantApiModel{{Name: "blue", Weight: 1000}}},
+	))
+}
+
+func TestStrategyPromotionPlanWaitsForPendingStrategyChanges(t *testing.T) {
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/admin/environments/project/default":
+			_, _ = w.Write([]byte(`{"version": 1, "environments": [
+				{"name": "development", "type": "development", "enabled": true, "protected": false, "sortOrder": 1},
+				{"name": "production", "type": "production", "enabled": true, "protected": false, "sortOrder": 2}
+			]}`))
+		case r.URL.Path == "/api/admin/projects/default/change-requests/config":
+			_, _ = w.Write([]byte(`[]`))
+		case strings.HasSuffix(r.URL.Path, "/strategies"):
+			t.Errorf("strategies read while another resource changes them")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	ctx := context.Background()
+	promotion := &featureStrategyPromotionResource{client: client}
+	schemaResp := resource.SchemaResponse{}
+	promotion.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	planned := tfsdk.Plan{Schema: schemaResp.Schema}
+	require.False(t, planned.Set(ctx, &featureStrategyPromotionResourceModel{
+		Project:              types.StringValue("default"),
+		Feature:              types.StringValue("checkout"),
+		SourceEnvironment:    types.StringValue("development"),
+		TargetEnvironment:    types.StringValue("production"),
+		Mode:                 types.StringValue(strategyPromotionModeReplace),
+		InSync:               types.BoolUnknown(),
+		Diff:                 types.ListUnknown(types.ObjectType{AttrTypes: strategyChangeAttrTypes}),
+		ChangeRequestMode:    types.StringValue(changeRequestModeDirect),
+		ChangeRequestWait:    types.BoolValue(false),
+		ChangeRequestTimeout: types.StringValue(defaultChangeRequestTimeout),
+		ChangeRequestId:      types.StringUnknown(),
+		ChangeRequestStatus:  types.StringUnknown(),
+	}).HasError())
+
+	planStrategyChanges(client, "default", "checkout", "development")
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+		Plan:  planned,
+	}
+	resp := resource.ModifyPlanResponse{Plan: planned}
+	promotion.ModifyPlan(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	var diff types.List
+	require.False(t, resp.Plan.GetAttribute(ctx, path.Root("diff"), &diff).HasError())
+	assert.True(t, diff.IsUnknown(), "the diff is worked out during the apply")
+	assert.True(t, hasPendingStrategyChanges(client, "default", "checkout", "production"), "promotions from production wait too")
+	assert.False(t, hasPendingStrategyChanges(&unleash.APIClient{}, "default", "checkout", "development"), "changes are tracked per client")
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpandFeatureStrategies(t *testing.T) {
+	var diagnostics diag.Diagnostics
+
+	strategies := expandFeatureStrategies(context.Background(), []featureStrategyModel{
+		{
+			Name:       types.StringValue("flexibleRollout"),
+			Title:      types.StringValue("Internal users"),
+			Parameters: types.MapValueMust(types.StringType, map[string]attr.Value{"rollout": types.StringValue("25")}),
+			Segments:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("3")}),
+			Variants: []strategyVariantModel{
+				{
+					Name:       types.StringValue("blue"),
+					Weight:     types.Int64Unknown(),
+					WeightType: types.StringValue("variable"),
+					Stickiness: types.StringValue("default"),
+					Payload:    &variantPayloadModel{Type: types.StringValue("string"), Value: types.StringValue("b")},
+				},
+			},
+		},
+		{
+			Name:       types.StringValue("default"),
+			Title:      types.StringNull(),
+			Parameters: types.MapNull(types.StringType),
+			Segments:   types.ListNull(types.StringType),
+		},
+	}, &diagnostics)
+
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if len(strategies) != 2 {
+		t.Fatalf("expected 2 strategies, got %d", len(strategies))
+	}
+	if *strategies[0].SortOrder != 0 || *strategies[1].SortOrder != 1 {
+		t.Fatal("expected strategies to be sorted in configuration order")
+	}
+	if strategies[0].Parameters["rollout"] != "25" {
+		t.Fatalf("unexpected parameters %v", strategies[0].Parameters)
+	}
+	if len(strategies[0].Segments) != 1 || strategies[0].Segments[0] != 3 {
+		t.Fatalf("unexpected segments %v", strategies[0].Segments)
+	}
+	if len(strategies[0].Variants) != 1 || strategies[0].Variants[0].Payload == nil {
+		t.Fatalf("unexpected variants %v", strategies[0].Variants)
+	}
+	if strategies[1].Title != nil {
+		t.Fatal("expected second strategy to have no title")
+	}
+	if strategies[1].Parameters == nil || strategies[1].Segments == nil || strategies[1].Variants == nil || strategies[1].Constraints == nil {
+		t.Fatal("expected unset lists and maps to be sent empty")
+	}
+}
+
+func TestExpandFeatureStrategiesRejectsInvalidSegment(t *testing.T) {
+	var diagnostics diag.Diagnostics
+
+	expandFeatureStrategies(context.Background(), []featureStrategyModel{
+		{
+			Name:       types.StringValue("default"),
+			Parameters: types.MapNull(types.StringType),
+			Segments:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("internal")}),
+		},
+	}, &diagnostics)
+
+	if !diagnostics.HasError() {
+		t.Fatal("expected an error for a segment id that is not a number")
+	}
+}
+
+func TestFlattenFeatureStrategiesPreservesEmptyAndNullLists(t *testing.T) {
+	var diagnostics diag.Diagnostics
+
+	if got := flattenFeatureStrategies(context.Background(), nil, nil, &diagnostics); got != nil {
+		t.Fatalf("expected nil strategies, got %v", got)
+	}
+	if got := flattenFeatureStrategies(context.Background(), []featureStrategyModel{}, nil, &diagnostics); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty strategies, got %v", got)
+	}
+
+	got := flattenFeatureStrategies(context.Background(), []featureStrategyModel{{Variants: []strategyVariantModel{}}}, []featureStrategyApiModel{
+		{Name: "default", Segments: []int64{7}},
+	}, &diagnostics)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if got[0].Variants == nil || len(got[0].Variants) != 0 {
+		t.Fatalf("expected configured empty variants to stay empty, got %v", got[0].Variants)
+	}
+	if !got[0].Title.IsNull() {
+		t.Fatal("expected title to be null")
+	}
+	if got[0].Parameters.IsNull() || len(got[0].Parameters.Elements()) != 0 {
+		t.Fatal("expected missing parameters to be an empty map")
+	}
+	if len(got[0].Segments.Elements()) != 1 || got[0].Segments.Elements()[0].(types.String).ValueString() != "7" {
+		t.Fatalf("unexpected segments %v", got[0].Segments)
+	}
+}
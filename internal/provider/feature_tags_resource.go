@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &featureTagsResource{}
+	_ resource.ResourceWithConfigure   = &featureTagsResource{}
+	_ resource.ResourceWithImportState = &featureTagsResource{}
+	_ resource.ResourceWithModifyPlan  = &featureTagsResource{}
+)
+
+func NewFeatureTagsResource() resource.Resource {
+	return &featureTagsResource{}
+}
+
+type featureTagsResource struct {
+	client *unleash.APIClient
+}
+
+type featureTagsResourceModel struct {
+	Feature types.String `tfsdk:"feature"`
+	Tags    []tagModel   `tfsdk:"tags"`
+}
+
+type tagModel struct {
+	Type  types.String `tfsdk:"type"`
+	Value types.String `tfsdk:"value"`
+}
+
+type tagApiModel struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type tagsApiModel struct {
+	Tags []tagApiModel `json:"tags"`
+}
+
+type updateTagsApiModel struct {
+	AddedTags   []tagApiModel `json:"addedTags"`
+	RemovedTags []tagApiModel `json:"removedTags"`
+}
+
+func (r *featureTagsResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *featureTagsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_tags"
+}
+
+func (r *featureTagsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the full set of tags on a feature flag. Tags added outside of Terraform are removed on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"feature": schema.StringAttribute{
+				Description: "The name of the feature flag.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.SetNestedAttribute{
+				Description: "The tags the feature flag should have. An empty set removes all tags.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The tag type, for example `team`. The tag type must already exist.",
+							Required:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the tag.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *featureTagsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *featureTagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import feature tags resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("feature"), req, resp)
+
+	tflog.Debug(ctx, "Finished importing feature tags resource", map[string]any{"success": true})
+}
+
+func (r *featureTagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create feature tags resource")
+	var plan featureTagsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.reconcile(ctx, plan.Feature.ValueString(), expandTags(plan.Tags), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating feature tags resource", map[string]any{"success": true})
+}
+
+func (r *featureTagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read feature tags resource")
+	var state featureTagsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags tagsApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featureTagsPath(state.Feature.ValueString()), nil, &tags)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Feature.ValueString(), "Feature") {
+		return
+	}
+
+	state.Tags = flattenTags(tags.Tags)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading feature tags resource", map[string]any{"success": true})
+}
+
+func (r *featureTagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update feature tags resource")
+	var plan featureTagsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.reconcile(ctx, plan.Feature.ValueString(), expandTags(plan.Tags), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating feature tags resource", map[string]any{"success": true})
+}
+
+func (r *featureTagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete feature tags resource")
+	var state featureTagsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags tagsApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featureTagsPath(state.Feature.ValueString()), nil, &tags)
+	if isNotFoundResponse(httpRes) {
+		// the feature is gone, and its tags with it
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	if !r.reconcile(ctx, state.Feature.ValueString(), []tagApiModel{}, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting feature tags resource", map[string]any{"success": true})
+}
+
+// reconcile makes the tags on the feature match the desired tags, adding and removing only what differs.
+func (r *featureTagsResource) reconcile(ctx context.Context, feature string, desired []tagApiModel, diagnostics *diag.Diagnostics) bool {
+	var current tagsApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, featureTagsPath(feature), nil, &current)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return false
+	}
+
+	update := diffTags(current.Tags, desired)
+	if len(update.AddedTags) == 0 && len(update.RemovedTags) == 0 {
+		return true
+	}
+
+	httpRes, err = adminApiRequest(ctx, r.client, http.MethodPut, featureTagsPath(feature), update, nil)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+func featureTagsPath(feature string) string {
+	return "/api/admin/features/" + url.PathEscape(feature) + "/tags"
+}
+
+func diffTags(current []tagApiModel, desired []tagApiModel) updateTagsApiModel {
+	update := updateTagsApiModel{
+		AddedTags:   []tagApiModel{},
+		RemovedTags: []tagApiModel{},
+	}
+
+	existing := make(map[tagApiModel]bool, len(current))
+	for _, tag := range current {
+		existing[tag] = true
+	}
+
+	wanted := make(map[tagApiModel]bool, len(desired))
+	for _, tag := range desired {
+		wanted[tag] = true
+		if !existing[tag] {
+			update.AddedTags = append(update.AddedTags, tag)
+		}
+	}
+
+	for _, tag := range current {
+		if !wanted[tag] {
+			update.RemovedTags = append(update.RemovedTags, tag)
+		}
+	}
+
+	return update
+}
+
+func expandTags(tags []tagModel) []tagApiModel {
+	result := make([]tagApiModel, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, tagApiModel{
+			Type:  tag.Type.ValueString(),
+			Value: tag.Value.ValueString(),
+		})
+	}
+	return result
+}
+
+func flattenTags(tags []tagApiModel) []tagModel {
+	result := make([]tagModel, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, tagModel{
+			Type:  types.StringValue(tag.Type),
+			Value: types.StringValue(tag.Value),
+		})
+	}
+	return result
+}
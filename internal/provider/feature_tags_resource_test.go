@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeatureTagsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-tagged-feature")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_tag_type" "owner" {
+						name = "tf-owner"
+					}
+
+					resource "unleash_feature_tags" "tagged" {
+						feature = "tf-tagged-feature"
+						tags = [
+							{
+								type  = unleash_tag_type.owner.name
+								value = "checkout"
+							},
+							{
+								type  = "simple"
+								value = "terraform"
+							}
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_tags.tagged", "feature", "tf-tagged-feature"),
+					resource.TestCheckResourceAttr("unleash_feature_tags.tagged", "tags.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("unleash_feature_tags.tagged", "tags.*", map[string]string{
+						"type":  "tf-owner",
+						"value": "checkout",
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_tag_type" "owner" {
+						name = "tf-owner"
+					}
+
+					resource "unleash_feature_tags" "tagged" {
+						feature = "tf-tagged-feature"
+						tags = [
+							{
+								type  = unleash_tag_type.owner.name
+								value = "payments"
+							}
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_feature_tags.tagged", "tags.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("unleash_feature_tags.tagged", "tags.*", map[string]string{
+						"type":  "tf-owner",
+						"value": "payments",
+					}),
+				),
+			},
+			{
+				ResourceName:                         "unleash_feature_tags.tagged",
+				ImportState:                          true,
+				ImportStateId:                        "tf-tagged-feature",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "feature",
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffTags(t *testing.T) {
+	current := []tagApiModel{
+		{Type: "team", Value: "checkout"},
+		{Type: "jira", Value: "CHK-1"},
+	}
+	desired := []tagApiModel{
+		{Type: "team", Value: "checkout"},
+		{Type: "slack", Value: "#checkout"},
+	}
+
+	update := diffTags(current, desired)
+
+	assert.Equal(t, []tagApiModel{{Type: "slack", Value: "#checkout"}}, update.AddedTags)
+	assert.Equal(t, []tagApiModel{{Type: "jira", Value: "CHK-1"}}, update.RemovedTags)
+}
+
+func TestDiffTagsWithoutChanges(t *testing.T) {
+	tags := []tagApiModel{{Type: "team", Value: "checkout"}}
+
+	update := diffTags(tags, tags)
+
+	assert.Empty(t, update.AddedTags)
+	assert.Empty(t, update.RemovedTags)
+}
+
+func TestDiffTagsRemovesEverything(t *testing.T) {
+	current := []tagApiModel{
+		{Type: "team", Value: "checkout"},
+		{Type: "jira", Value: "CHK-1"},
+	}
+
+	update := diffTags(current, []tagApiModel{})
+
+	assert.Empty(t, update.AddedTags)
+	assert.Equal(t, current, update.RemovedTags)
+}
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &featuresDataSource{}
+	_ datasource.DataSourceWithConfigure = &featuresDataSource{}
+)
+
+var searchDatePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func NewFeaturesDataSource() datasource.DataSource {
+	return &featuresDataSource{}
+}
+
+type featuresDataSource struct {
+	client *unleash.APIClient
+}
+
+type featuresDataSourceModel struct {
+	Project       types.String           `tfsdk:"project"`
+	Tag           *tagModel              `tfsdk:"tag"`
+	Type          types.String           `tfsdk:"type"`
+	State         types.String           `tfsdk:"state"`
+	CreatedBy     types.Int64            `tfsdk:"created_by"`
+	CreatedAfter  types.String           `tfsdk:"created_after"`
+	CreatedBefore types.String           `tfsdk:"created_before"`
+	Query         types.String           `tfsdk:"query"`
+	Features      []searchedFeatureModel `tfsdk:"features"`
+}
+
+type searchedFeatureModel struct {
+	Name           types.String                      `tfsdk:"name"`
+	Project        types.String                      `tfsdk:"project"`
+	Type           types.String                      `tfsdk:"type"`
+	Description    types.String                      `tfsdk:"description"`
+	Stale          types.Bool                        `tfsdk:"stale"`
+	ImpressionData types.Bool                        `tfsdk:"impression_data"`
+	CreatedAt      types.String                      `tfsdk:"created_at"`
+	LastSeenAt     types.String                      `tfsdk:"last_seen_at"`
+	Tags           []tagModel                        `tfsdk:"tags"`
+	Environments   []searchedFeatureEnvironmentModel `tfsdk:"environments"`
+}
+
+type searchedFeatureEnvironmentModel struct {
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Enabled    types.Bool   `tfsdk:"enabled"`
+	LastSeenAt types.String `tfsdk:"last_seen_at"`
+}
+
+func (d *featuresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *featuresDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_features"
+}
+
+func (d *featuresDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	dateValidators := []validator.String{
+		stringvalidator.RegexMatches(searchDatePattern, "must be a date in the YYYY-MM-DD format"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Search the feature flags of all projects. Every filter is optional and filters combine, so that only features matching all of them are returned.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "Only return features in this project.",
+				Optional:    true,
+			},
+			"tag": schema.SingleNestedAttribute{
+				Description: "Only return features with this tag.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "The tag type.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The tag value.",
+						Required:    true,
+					},
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return features of this type, such as `release` or `kill-switch`.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return features in this state. One of `active`, `stale` or `potentially-stale`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "stale", "potentially-stale"),
+				},
+			},
+			"created_by": schema.Int64Attribute{
+				Description: "Only return features created by the user with this id.",
+				Optional:    true,
+			},
+			"created_after": schema.StringAttribute{
+				Description: "Only return features created on or after this date, in the YYYY-MM-DD format.",
+				Optional:    true,
+				Validators:  dateValidators,
+			},
+			"created_before": schema.StringAttribute{
+				Description: "Only return features created before this date, in the YYYY-MM-DD format.",
+				Optional:    true,
+				Validators:  dateValidators,
+			},
+			"query": schema.StringAttribute{
+				Description: "Only return features whose name or description contains this text.",
+				Optional:    true,
+			},
+			"features": schema.ListNestedAttribute{
+				Description: "The matching features.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the feature.",
+							Computed:    true,
+						},
+						"project": schema.StringAttribute{
+							Description: "The project of the feature.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the feature.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the feature.",
+							Computed:    true,
+						},
+						"stale": schema.BoolAttribute{
+							Description: "Whether the feature is marked as stale.",
+							Computed:    true,
+						},
+						"impression_data": schema.BoolAttribute{
+							Description: "Whether impression data is emitted for the feature.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the feature was created.",
+							Computed:    true,
+						},
+						"last_seen_at": schema.StringAttribute{
+							Description: "When an SDK last reported the feature, or null if it never did.",
+							Computed:    true,
+						},
+						"tags": schema.ListNestedAttribute{
+							Description: "The tags of the feature.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "The tag type.",
+										Computed:    true,
+									},
+									"value": schema.StringAttribute{
+										Description: "The tag value.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"environments": schema.ListNestedAttribute{
+							Description: "The environments of the feature.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name of the environment.",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "The type of the environment.",
+										Computed:    true,
+									},
+									"enabled": schema.BoolAttribute{
+										Description: "Whether the feature is enabled in the environment.",
+										Computed:    true,
+									},
+									"last_seen_at": schema.StringAttribute{
+										Description: "When an SDK last reported the feature in the environment, or null if it never did.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *featuresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read features data source")
+	var state featuresDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parameters, createdBefore := state.searchParameters()
+	features, ok := searchFeatures(ctx, d.client, parameters, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	state.Features = []searchedFeatureModel{}
+	for _, feature := range features {
+		if createdBefore != "" && (feature.CreatedAt == nil || *feature.CreatedAt >= createdBefore) {
+			continue
+		}
+		state.Features = append(state.Features, flattenSearchedFeature(feature))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading features data source", map[string]any{"success": true})
+}
+
+// searchParameters translates the filters to feature search parameters. The search API takes a single creation date
+// condition, so when both ends of the range are set the end is returned to be applied to the results instead.
+func (m featuresDataSourceModel) searchParameters() (url.Values, string) {
+	parameters := url.Values{}
+	if !m.Project.IsNull() {
+		parameters.Set("project", "IS:"+m.Project.ValueString())
+	}
+	if m.Tag != nil {
+		parameters.Set("tag", "INCLUDE:"+m.Tag.Type.ValueString()+":"+m.Tag.Value.ValueString())
+	}
+	if !m.Type.IsNull() {
+		parameters.Set("type", "IS:"+m.Type.ValueString())
+	}
+	if !m.State.IsNull() {
+		parameters.Set("state", "IS:"+m.State.ValueString())
+	}
+	if !m.CreatedBy.IsNull() {
+		parameters.Set("createdBy", "IS:"+strconv.FormatInt(m.CreatedBy.ValueInt64(), 10))
+	}
+	if !m.Query.IsNull() {
+		parameters.Set("query", m.Query.ValueString())
+	}
+
+	createdBefore := ""
+	switch {
+	case !m.CreatedAfter.IsNull():
+		parameters.Set("createdAt", "IS_ON_OR_AFTER:"+m.CreatedAfter.ValueString())
+		createdBefore = m.CreatedBefore.ValueString()
+	case !m.CreatedBefore.IsNull():
+		parameters.Set("createdAt", "IS_BEFORE:"+m.CreatedBefore.ValueString())
+	}
+
+	return parameters, createdBefore
+}
+
+func flattenSearchedFeature(feature featureSearchApiModel) searchedFeatureModel {
+	model := searchedFeatureModel{
+		Name:           types.StringValue(feature.Name),
+		Project:        types.StringValue(feature.Project),
+		Type:           types.StringValue(feature.Type),
+		Description:    types.StringPointerValue(feature.Description),
+		Stale:          types.BoolValue(feature.Stale),
+		ImpressionData: types.BoolValue(feature.ImpressionData),
+		CreatedAt:      types.StringPointerValue(feature.CreatedAt),
+		LastSeenAt:     types.StringPointerValue(feature.LastSeenAt),
+		Tags:           make([]tagModel, 0, len(feature.Tags)),
+		Environments:   make([]searchedFeatureEnvironmentModel, 0, len(feature.Environments)),
+	}
+
+	for _, tag := range feature.Tags {
+		model.Tags = append(model.Tags, tagModel{
+			Type:  types.StringValue(tag.Type),
+			Value: types.StringValue(tag.Value),
+		})
+	}
+	for _, environment := range feature.Environments {
+		model.Environments = append(model.Environments, searchedFeatureEnvironmentModel{
+			Name:       types.StringValue(environment.Name),
+			Type:       types.StringValue(environment.Type),
+			Enabled:    types.BoolValue(environment.Enabled),
+			LastSeenAt: types.StringPointerValue(environment.LastSeenAt),
+		})
+	}
+
+	return model
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFeaturesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-search-enabled")
+			testAccCreateFeature(t, "default", "tf-search-disabled")
+			testAccEnableFeature(t, "default", "tf-search-enabled", "development")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unleash_features" "search" {
+						project = "default"
+						query   = "tf-search-"
+					}
+
+					locals {
+						enabled_in_development = [
+							for feature in data.unleash_features.search.features : feature.name
+							if anytrue([for environment in feature.environments : environment.name == "development" && environment.enabled])
+						]
+					}
+
+					output "enabled_in_development" {
+						value = join(",", local.enabled_in_development)
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_features.search", "features.#", "2"),
+					resource.TestCheckOutput("enabled_in_development", "tf-search-enabled"),
+				),
+			},
+			{
+				Config: `
+					data "unleash_features" "search" {
+						project = "default"
+						query   = "tf-search-"
+						type    = "kill-switch"
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("data.unleash_features.search", "features.#", "0"),
+			},
+		},
+	})
+}
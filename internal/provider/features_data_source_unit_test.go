@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeaturesSearchParameters(t *testing.T) {
+	model := featuresDataSourceModel{
+		Project:       types.StringValue("payments"),
+		Tag:           &tagModel{Type: types.StringValue("simple"), Value: types.StringValue("checkout")},
+		Type:          types.StringValue("release"),
+		State:         types.StringValue("stale"),
+		CreatedBy:     types.Int64Value(7),
+		CreatedAfter:  types.StringValue("2024-01-01"),
+		CreatedBefore: types.StringValue("2024-02-01"),
+		Query:         types.StringValue("banner"),
+	}
+
+	parameters, createdBefore := model.searchParameters()
+	assert.Equal(t, "IS:payments", parameters.Get("project"))
+	assert.Equal(t, "INCLUDE:simple:checkout", parameters.Get("tag"))
+	assert.Equal(t, "IS:release", parameters.Get("type"))
+	assert.Equal(t, "IS:stale", parameters.Get("state"))
+	assert.Equal(t, "IS:7", parameters.Get("createdBy"))
+	assert.Equal(t, "banner", parameters.Get("query"))
+	assert.Equal(t, []string{"IS_ON_OR_AFTER:2024-01-01"}, parameters["createdAt"])
+	assert.Equal(t, "2024-02-01", createdBefore, "the end of the range is applied to the results")
+
+	parameters, createdBefore = featuresDataSourceModel{CreatedBefore: types.StringValue("2024-02-01")}.searchParameters()
+	assert.Equal(t, "IS_BEFORE:2024-02-01", parameters.Get("createdAt"))
+	assert.Empty(t, createdBefore)
+
+	parameters, _ = featuresDataSourceModel{}.searchParameters()
+	assert.Empty(t, parameters, "without filters every feature is returned")
+}
+
+func TestFlattenSearchedFeature(t *testing.T) {
+	createdAt := "2024-01-28T10:00:00.000Z"
+	feature := flattenSearchedFeature(featureSearchApiModel{
+		Name:      "checkout",
+		Project:   "payments",
+		Type:      "release",
+		CreatedAt: &createdAt,
+		Tags:      []tagApiModel{{Type: "simple", Value: "team-a"}},
+		Environments: []featureEnvironmentApiModel{
+			{Name: "production", Type: "production", Enabled: true},
+		},
+	})
+
+	assert.Equal(t, "checkout", feature.Name.ValueString())
+	assert.True(t, feature.Description.IsNull())
+	assert.True(t, feature.LastSeenAt.IsNull())
+	assert.Equal(t, createdAt, feature.CreatedAt.ValueString())
+	require.Len(t, feature.Tags, 1)
+	assert.Equal(t, "team-a", feature.Tags[0].Value.ValueString())
+	require.Len(t, feature.Environments, 1)
+	assert.True(t, feature.Environments[0].Enabled.ValueBool())
+	assert.Equal(t, "production", feature.Environments[0].Type.ValueString())
+}
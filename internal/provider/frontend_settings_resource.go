@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &frontendSettingsResource{}
+	_ resource.ResourceWithConfigure      = &frontendSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &frontendSettingsResource{}
+	_ resource.ResourceWithModifyPlan     = &frontendSettingsResource{}
+)
+
+const uiConfigPath = "/api/admin/ui-config"
+
+func NewFrontendSettingsResource() resource.Resource {
+	return &frontendSettingsResource{}
+}
+
+type frontendSettingsResource struct {
+	client *unleash.APIClient
+}
+
+type frontendSettingsResourceModel struct {
+	CorsOrigins []types.String `tfsdk:"cors_origins"`
+}
+
+type frontendSettingsApiModel struct {
+	FrontendApiOrigins []string `json:"frontendApiOrigins"`
+}
+
+func (r *frontendSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *frontendSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_frontend_settings"
+}
+
+func (r *frontendSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the settings of the frontend API, which browsers and mobile apps use to evaluate flags. " +
+			"There is only one set of frontend settings per instance, so declare this resource at most once. " +
+			"Destroying it allows every origin again, which is what Unleash does by default. " +
+			"Whether the frontend API is enabled can't be managed: Unleash has no API for it, and from Unleash 5 the frontend API is always on.",
+		Attributes: map[string]schema.Attribute{
+			"cors_origins": schema.SetAttribute{
+				Description: "The origins the frontend API accepts requests from, such as `https://app.example.com`, or `*` to accept requests from anywhere. " +
+					"An empty set blocks requests from browsers.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *frontendSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var origins types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("cors_origins"), &origins)...)
+	if resp.Diagnostics.HasError() || origins.IsNull() || origins.IsUnknown() {
+		return
+	}
+
+	for _, element := range origins.Elements() {
+		origin, ok := element.(types.String)
+		if !ok || origin.IsUnknown() || origin.IsNull() {
+			continue
+		}
+		if err := validateCorsOrigin(origin.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cors_origins").AtSetValue(origin),
+				"Invalid CORS origin",
+				err.Error(),
+			)
+		}
+	}
+}
+
+func (r *frontendSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *frontendSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create frontend settings resource")
+	var plan frontendSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.setCorsOrigins(ctx, stringSliceValues(plan.CorsOrigins), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating frontend settings resource", map[string]any{"success": true})
+}
+
+func (r *frontendSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read frontend settings resource")
+	var state frontendSettingsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings frontendSettingsApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, uiConfigPath, nil, &settings)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.CorsOrigins = stringValues(settings.FrontendApiOrigins)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading frontend settings resource", map[string]any{"success": true})
+}
+
+func (r *frontendSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update frontend settings resource")
+	var plan frontendSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.setCorsOrigins(ctx, stringSliceValues(plan.CorsOrigins), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating frontend settings resource", map[string]any{"success": true})
+}
+
+func (r *frontendSettingsResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete frontend settings resource")
+
+	if !r.setCorsOrigins(ctx, []string{"*"}, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting frontend settings resource", map[string]any{"success": true})
+}
+
+func (r *frontendSettingsResource) setCorsOrigins(ctx context.Context, origins []string, diagnostics *diag.Diagnostics) bool {
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, uiConfigPath+"/cors", frontendSettingsApiModel{FrontendApiOrigins: origins}, nil)
+	return ValidateApiResponse(httpRes, 204, diagnostics, err)
+}
+
+// validateCorsOrigin accepts `*` and origins, which are URLs without a path, query or fragment.
+func validateCorsOrigin(origin string) error {
+	if origin == "*" {
+		return nil
+	}
+
+	parsed, err := url.Parse(origin)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("%q must be * or a URL such as https://app.example.com", origin)
+	}
+	if parsed.Path != "" || parsed.RawQuery != "" || parsed.Fragment != "" || parsed.User != nil {
+		return fmt.Errorf("%q must be an origin, which is a URL without a path, trailing slash, query or credentials, such as %s://%s", origin, parsed.Scheme, parsed.Host)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFrontendSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_frontend_settings" "settings" {
+						cors_origins = ["https://app.example.com/"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Invalid CORS origin`),
+			},
+			{
+				Config: `
+					resource "unleash_frontend_settings" "settings" {
+						cors_origins = ["https://app.example.com", "http://localhost:3000"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_frontend_settings.settings", "cors_origins.#", "2"),
+					resource.TestCheckTypeSetElemAttr("unleash_frontend_settings.settings", "cors_origins.*", "https://app.example.com"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_frontend_settings" "settings" {
+						cors_origins = ["*"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_frontend_settings.settings", "cors_origins.#", "1"),
+					resource.TestCheckTypeSetElemAttr("unleash_frontend_settings.settings", "cors_origins.*", "*"),
+				),
+			},
+		},
+	})
+}
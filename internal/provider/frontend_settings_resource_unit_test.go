@@ -0,0 +1,17 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCorsOrigin(t *testing.T) {
+	for _, origin := range []string{"*", "https://app.example.com", "http://localhost:3000"} {
+		assert.NoError(t, validateCorsOrigin(origin), origin)
+	}
+
+	for _, origin := range []string{"", "app.example.com", "https://", "https://app.example.com/", "https://app.example.com/path", "https://app.example.com?x=1", "https://user@app.example.com", "*.example.com"} {
+		assert.Error(t, validateCorsOrigin(origin), origin)
+	}
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &groupResource{}
 	_ resource.ResourceWithConfigure   = &groupResource{}
 	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.ResourceWithModifyPlan  = &groupResource{}
 )
 
 // NewGroupResource is a helper function to simplify the provider implementation.
@@ -38,6 +41,7 @@ type groupResourceModel struct {
 	MappingsSSO types.List   `tfsdk:"mappings_sso"`
 	RootRole    types.Int64  `tfsdk:"root_role"`
 	Users       types.List   `tfsdk:"users"`
+	ScimManaged types.Bool   `tfsdk:"scim_managed"`
 }
 
 // Helper function to convert API users to Terraform model.
@@ -189,6 +193,8 @@ func populateGroupStateFromAPI(ctx context.Context, group *unleash.GroupSchema,
 	})
 
 	state.Users = int64ListStateValue(ctx, state.Users, convertAPIUsersToModel(group.Users), diagnostics)
+
+	state.ScimManaged = types.BoolValue(group.GetScimId() != "")
 }
 
 // Configure adds the provider configured client to the resource.
@@ -240,10 +246,22 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				ElementType: types.Int64Type,
 			},
+			"scim_managed": schema.BoolAttribute{
+				Description: fmt.Sprintf(scimManagedDescription, "group"),
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
+func (r *groupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+	refuseScimManagedChanges(ctx, "group", req, resp)
+}
+
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Preparing to import group resource")
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
@@ -46,6 +46,7 @@ func TestAccGroupResource(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("unleash_group.test_group", "id"),
 					resource.TestCheckResourceAttr("unleash_group.test_group", "name", "Test Group"),
+					resource.TestCheckResourceAttr("unleash_group.test_group", "scim_managed", "false"),
 				),
 			},
 			// Test 2: Update group to add description
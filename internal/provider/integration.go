@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// integrationProvider describes an addon provider the unleash_integration resource has a block for.
+type integrationProvider struct {
+	attribute   string
+	name        string
+	description string
+	parameters  []integrationParameter
+}
+
+// integrationParameter maps an attribute of an integration block to an addon parameter. Sensitive parameters are
+// write-only: Terraform doesn't store them, and Unleash never returns them, so only a hash of them is kept in state.
+type integrationParameter struct {
+	attribute   string
+	name        string
+	description string
+	required    bool
+	sensitive   bool
+}
+
+var integrationProviders = []integrationProvider{
+	{
+		attribute:   "webhook",
+		name:        "webhook",
+		description: "Posts events to an HTTP endpoint.",
+		parameters: []integrationParameter{
+			{attribute: "url", name: "url", description: "The URL to post events to.", required: true, sensitive: true},
+			{attribute: "content_type", name: "contentType", description: "The content type of the request. Defaults to `application/json`."},
+			{attribute: "authorization", name: "authorization", description: "The value of the Authorization header.", sensitive: true},
+			{attribute: "body_template", name: "bodyTemplate", description: "A Mustache template for the request body. Without a template the event is posted as JSON."},
+			{attribute: "custom_headers", name: "customHeaders", description: "Extra headers as a JSON object.", sensitive: true},
+		},
+	},
+	{
+		attribute:   "slack_app",
+		name:        "slack-app",
+		description: "Posts events to Slack channels through the Unleash Slack app.",
+		parameters: []integrationParameter{
+			{attribute: "access_token", name: "accessToken", description: "The access token of the Slack app.", required: true, sensitive: true},
+			{attribute: "default_channels", name: "defaultChannels", description: "Comma separated channels to post to, in addition to the channels from the `slack` tags of a feature."},
+		},
+	},
+	{
+		attribute:   "teams",
+		name:        "teams",
+		description: "Posts events to a Microsoft Teams channel.",
+		parameters: []integrationParameter{
+			{attribute: "url", name: "url", description: "The incoming webhook URL of the channel.", required: true, sensitive: true},
+			{attribute: "custom_headers", name: "customHeaders", description: "Extra headers as a JSON object.", sensitive: true},
+		},
+	},
+	{
+		attribute:   "datadog",
+		name:        "datadog",
+		description: "Sends events to the Datadog events API.",
+		parameters: []integrationParameter{
+			{attribute: "url", name: "url", description: "The Datadog events API URL, for example `https://api.datadoghq.eu/api/v1/events`.", required: true},
+			{attribute: "api_key", name: "apiKey", description: "The Datadog API key.", required: true, sensitive: true},
+			{attribute: "source_type_name", name: "sourceTypeName", description: "The source type name of the events."},
+			{attribute: "custom_headers", name: "customHeaders", description: "Extra headers as a JSON object.", sensitive: true},
+			{attribute: "body_template", name: "bodyTemplate", description: "A Mustache template for the event text."},
+		},
+	},
+	{
+		attribute:   "new_relic",
+		name:        "new-relic",
+		description: "Sends events to the New Relic event API.",
+		parameters: []integrationParameter{
+			{attribute: "url", name: "url", description: "The New Relic event API URL of the account.", required: true},
+			{attribute: "license_key", name: "licenseKey", description: "The New Relic license key.", required: true, sensitive: true},
+			{attribute: "custom_headers", name: "customHeaders", description: "Extra headers as a JSON object.", sensitive: true},
+			{attribute: "body_template", name: "bodyTemplate", description: "A Mustache template for the event text."},
+		},
+	},
+}
+
+type addonApiModel struct {
+	Id           int64                      `json:"id,omitempty"`
+	Provider     string                     `json:"provider"`
+	Description  *string                    `json:"description"`
+	Enabled      bool                       `json:"enabled"`
+	Parameters   map[string]json.RawMessage `json:"parameters"`
+	Events       []string                   `json:"events"`
+	Projects     []string                   `json:"projects"`
+	Environments []string                   `json:"environments"`
+}
+
+type addonsApiModel struct {
+	Providers []addonProviderApiModel `json:"providers"`
+}
+
+type addonProviderApiModel struct {
+	Name             string                   `json:"name"`
+	DisplayName      string                   `json:"displayName"`
+	Description      string                   `json:"description"`
+	DocumentationUrl string                   `json:"documentationUrl"`
+	Deprecated       *string                  `json:"deprecated"`
+	Events           []string                 `json:"events"`
+	Parameters       []addonParameterApiModel `json:"parameters"`
+}
+
+type addonParameterApiModel struct {
+	Name        string  `json:"name"`
+	DisplayName string  `json:"displayName"`
+	Type        string  `json:"type"`
+	Description *string `json:"description"`
+	Placeholder *string `json:"placeholder"`
+	Required    bool    `json:"required"`
+	Sensitive   bool    `json:"sensitive"`
+}
+
+func addonPath(id string) string {
+	return "/api/admin/addons/" + url.PathEscape(id)
+}
+
+// addonParameterString reads a parameter value, which Unleash stores as a string but doesn't enforce.
+func addonParameterString(raw json.RawMessage) (string, bool) {
+	var value string
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value, value != ""
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", false
+	}
+	return string(raw), true
+}
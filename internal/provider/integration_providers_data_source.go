@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &integrationProvidersDataSource{}
+	_ datasource.DataSourceWithConfigure = &integrationProvidersDataSource{}
+)
+
+func NewIntegrationProvidersDataSource() datasource.DataSource {
+	return &integrationProvidersDataSource{}
+}
+
+type integrationProvidersDataSource struct {
+	client *unleash.APIClient
+}
+
+type integrationProvidersDataSourceModel struct {
+	Providers []integrationProviderModel `tfsdk:"providers"`
+}
+
+type integrationProviderModel struct {
+	Name             types.String                        `tfsdk:"name"`
+	DisplayName      types.String                        `tfsdk:"display_name"`
+	Description      types.String                        `tfsdk:"description"`
+	DocumentationUrl types.String                        `tfsdk:"documentation_url"`
+	Deprecated       types.String                        `tfsdk:"deprecated"`
+	Events           []types.String                      `tfsdk:"events"`
+	Parameters       []integrationProviderParameterModel `tfsdk:"parameters"`
+}
+
+type integrationProviderParameterModel struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Placeholder types.String `tfsdk:"placeholder"`
+	Required    types.Bool   `tfsdk:"required"`
+	Sensitive   types.Bool   `tfsdk:"sensitive"`
+}
+
+func (d *integrationProvidersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *integrationProvidersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_providers"
+}
+
+func (d *integrationProvidersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the integration providers, which Unleash calls addon providers, available on the instance, with the parameters and events they support.",
+		Attributes: map[string]schema.Attribute{
+			"providers": schema.ListNestedAttribute{
+				Description: "The available providers.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the provider, such as `webhook` or `slack-app`.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "The name of the provider as shown in the Unleash UI.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the provider.",
+							Computed:    true,
+						},
+						"documentation_url": schema.StringAttribute{
+							Description: "Where to find the documentation of the provider.",
+							Computed:    true,
+						},
+						"deprecated": schema.StringAttribute{
+							Description: "Why the provider is deprecated, or null if it isn't.",
+							Computed:    true,
+						},
+						"events": schema.ListAttribute{
+							Description: "The event types the provider can be triggered by.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"parameters": schema.ListNestedAttribute{
+							Description: "The parameters of the provider.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name of the parameter.",
+										Computed:    true,
+									},
+									"display_name": schema.StringAttribute{
+										Description: "The name of the parameter as shown in the Unleash UI.",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "The input type of the parameter, such as `url` or `textfield`.",
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "A description of the parameter.",
+										Computed:    true,
+									},
+									"placeholder": schema.StringAttribute{
+										Description: "An example value of the parameter.",
+										Computed:    true,
+									},
+									"required": schema.BoolAttribute{
+										Description: "Whether the parameter must be set.",
+										Computed:    true,
+									},
+									"sensitive": schema.BoolAttribute{
+										Description: "Whether the parameter is sensitive. Unleash never returns the value of sensitive parameters.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *integrationProvidersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read integration providers data source")
+	var state integrationProvidersDataSourceModel
+
+	var addons addonsApiModel
+	httpRes, err := adminApiRequest(ctx, d.client, http.MethodGet, "/api/admin/addons", nil, &addons)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.Providers = make([]integrationProviderModel, 0, len(addons.Providers))
+	for _, provider := range addons.Providers {
+		model := integrationProviderModel{
+			Name:             types.StringValue(provider.Name),
+			DisplayName:      types.StringValue(provider.DisplayName),
+			Description:      types.StringValue(provider.Description),
+			DocumentationUrl: types.StringValue(provider.DocumentationUrl),
+			Deprecated:       types.StringPointerValue(provider.Deprecated),
+			Events:           stringValues(provider.Events),
+			Parameters:       make([]integrationProviderParameterModel, 0, len(provider.Parameters)),
+		}
+		for _, parameter := range provider.Parameters {
+			model.Parameters = append(model.Parameters, integrationProviderParameterModel{
+				Name:        types.StringValue(parameter.Name),
+				DisplayName: types.StringValue(parameter.DisplayName),
+				Type:        types.StringValue(parameter.Type),
+				Description: types.StringPointerValue(parameter.Description),
+				Placeholder: types.StringPointerValue(parameter.Placeholder),
+				Required:    types.BoolValue(parameter.Required),
+				Sensitive:   types.BoolValue(parameter.Sensitive),
+			})
+		}
+		state.Providers = append(state.Providers, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading integration providers data source", map[string]any{"success": true})
+}
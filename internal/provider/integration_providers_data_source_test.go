@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationProvidersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unleash_integration_providers" "all" {}
+
+					locals {
+						webhook = one([for provider in data.unleash_integration_providers.all.providers : provider if provider.name == "webhook"])
+					}
+
+					output "webhook_sensitive_parameters" {
+						value = join(",", sort([for parameter in local.webhook.parameters : parameter.name if parameter.sensitive]))
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unleash_integration_providers.all", "providers.#"),
+					resource.TestMatchOutput("webhook_sensitive_parameters", regexp.MustCompile("url")),
+				),
+			},
+		},
+	})
+}
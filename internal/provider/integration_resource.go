@@ -0,0 +1,474 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &integrationResource{}
+	_ resource.ResourceWithConfigure      = &integrationResource{}
+	_ resource.ResourceWithImportState    = &integrationResource{}
+	_ resource.ResourceWithValidateConfig = &integrationResource{}
+	_ resource.ResourceWithModifyPlan     = &integrationResource{}
+)
+
+func NewIntegrationResource() resource.Resource {
+	return &integrationResource{}
+}
+
+type integrationResource struct {
+	client *unleash.APIClient
+}
+
+type integrationResourceModel struct {
+	Id                      types.String   `tfsdk:"id"`
+	Description             types.String   `tfsdk:"description"`
+	Enabled                 types.Bool     `tfsdk:"enabled"`
+	Events                  []types.String `tfsdk:"events"`
+	Projects                []types.String `tfsdk:"projects"`
+	Environments            []types.String `tfsdk:"environments"`
+	SensitiveParametersHash types.String   `tfsdk:"sensitive_parameters_hash"`
+	Webhook                 types.Object   `tfsdk:"webhook"`
+	SlackApp                types.Object   `tfsdk:"slack_app"`
+	Teams                   types.Object   `tfsdk:"teams"`
+	Datadog                 types.Object   `tfsdk:"datadog"`
+	NewRelic                types.Object   `tfsdk:"new_relic"`
+}
+
+func (r *integrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *integrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *integrationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an integration, which Unleash calls an addon, that forwards Unleash events to another service. " +
+			"Exactly one of the provider attributes must be set. Sensitive parameters are write-only, which requires Terraform 1.11 or later: " +
+			"they are sent to Unleash but never stored in the Terraform state, which only keeps a hash of them in `sensitive_parameters_hash` to detect changes. " +
+			"Unleash never returns sensitive parameters, so changes made to them outside of Terraform aren't detected, " +
+			"and after an import they are updated with the configured values on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the integration.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the integration.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the integration is enabled. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"events": schema.SetAttribute{
+				Description: "The event types that trigger the integration, such as `feature-created`. The `unleash_integration_providers` data source lists the events every provider supports.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"projects": schema.SetAttribute{
+				Description: "Only forward events from these projects. Events from every project are forwarded when not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"environments": schema.SetAttribute{
+				Description: "Only forward events from these environments. Events from every environment are forwarded when not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"sensitive_parameters_hash": schema.StringAttribute{
+				Description: "A SHA-256 hash of the configured sensitive parameters. Changing a sensitive parameter changes the hash, which updates the integration.",
+				Computed:    true,
+			},
+		},
+	}
+
+	for _, provider := range integrationProviders {
+		resp.Schema.Attributes[provider.attribute] = provider.schemaAttribute()
+	}
+}
+
+func (r *integrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	configured := []string{}
+	for _, provider := range integrationProviders {
+		var block types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(provider.attribute), &block)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if block.IsUnknown() {
+			return
+		}
+		if !block.IsNull() {
+			configured = append(configured, provider.attribute)
+		}
+	}
+
+	if len(configured) != 1 {
+		attributes := make([]string, 0, len(integrationProviders))
+		for _, provider := range integrationProviders {
+			attributes = append(attributes, provider.attribute)
+		}
+		resp.Diagnostics.AddError(
+			"Invalid integration",
+			fmt.Sprintf("Exactly one of %s must be set, got %d.", strings.Join(attributes, ", "), len(configured)),
+		)
+	}
+}
+
+// ModifyPlan plans the hash of the sensitive parameters. They are write-only, so they are null in the plan and the
+// hash is what tells Terraform they changed.
+func (r *integrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config integrationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash := config.sensitiveParametersHash(&resp.Diagnostics)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sensitive_parameters_hash"), hash)...)
+}
+
+func (r *integrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import integration resource")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, "Finished importing integration resource", map[string]any{"success": true})
+}
+
+func (r *integrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create integration resource")
+	var plan, config integrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.toApi(config, &resp.Diagnostics)
+	plan.SensitiveParametersHash = config.sensitiveParametersHash(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var addon addonApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/addons", request, &addon)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(addon, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating integration resource", map[string]any{"success": true})
+}
+
+func (r *integrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read integration resource")
+	var state integrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var addon addonApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, addonPath(state.Id.ValueString()), nil, &addon)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Id.ValueString(), "Integration") {
+		return
+	}
+
+	state.hydrateFromApi(addon, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading integration resource", map[string]any{"success": true})
+}
+
+func (r *integrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update integration resource")
+	var plan, config integrationResourceModel
+	var state integrationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = state.Id
+
+	request := plan.toApi(config, &resp.Diagnostics)
+	plan.SensitiveParametersHash = config.sensitiveParametersHash(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var addon addonApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, addonPath(plan.Id.ValueString()), request, &addon)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(addon, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating integration resource", map[string]any{"success": true})
+}
+
+func (r *integrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete integration resource")
+	var state integrationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, addonPath(state.Id.ValueString()), nil, nil)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting integration resource", map[string]any{"success": true})
+}
+
+func (m *integrationResourceModel) blocks() map[string]*types.Object {
+	return map[string]*types.Object{
+		"webhook":   &m.Webhook,
+		"slack_app": &m.SlackApp,
+		"teams":     &m.Teams,
+		"datadog":   &m.Datadog,
+		"new_relic": &m.NewRelic,
+	}
+}
+
+// toApi builds the addon from the plan. The provider parameters come from the configuration, since sensitive
+// parameters are write-only and therefore null in the plan.
+func (m *integrationResourceModel) toApi(config integrationResourceModel, diagnostics *diag.Diagnostics) addonApiModel {
+	addon := addonApiModel{
+		Description:  m.Description.ValueStringPointer(),
+		Enabled:      m.Enabled.ValueBool(),
+		Parameters:   map[string]json.RawMessage{},
+		Events:       stringSliceValues(m.Events),
+		Projects:     stringSliceValues(m.Projects),
+		Environments: stringSliceValues(m.Environments),
+	}
+
+	blocks := config.blocks()
+	for _, provider := range integrationProviders {
+		block := blocks[provider.attribute]
+		if block.IsNull() {
+			continue
+		}
+
+		addon.Provider = provider.name
+		attributes := block.Attributes()
+		for _, parameter := range provider.parameters {
+			value, ok := attributes[parameter.attribute].(types.String)
+			if !ok || value.IsNull() {
+				continue
+			}
+			raw, err := json.Marshal(value.ValueString())
+			if err != nil {
+				diagnostics.AddError("Unable to encode integration parameter", err.Error())
+				return addon
+			}
+			addon.Parameters[parameter.name] = raw
+		}
+	}
+
+	return addon
+}
+
+func (m *integrationResourceModel) hydrateFromApi(addon addonApiModel, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(strconv.FormatInt(addon.Id, 10))
+	m.Enabled = types.BoolValue(addon.Enabled)
+	m.Events = stringValues(addon.Events)
+	m.Projects = optionalStringValues(addon.Projects)
+	m.Environments = optionalStringValues(addon.Environments)
+
+	if addon.Description != nil && *addon.Description != "" {
+		m.Description = types.StringValue(*addon.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+
+	supported := false
+	for attribute, block := range m.blocks() {
+		provider := integrationProviderByAttribute(attribute)
+		if provider.name != addon.Provider {
+			*block = types.ObjectNull(provider.attributeTypes())
+			continue
+		}
+		supported = true
+		*block = provider.flatten(addon.Parameters, diagnostics)
+	}
+
+	if !supported {
+		diagnostics.AddError(
+			"Unsupported integration provider",
+			fmt.Sprintf("Integration %d uses the %s provider, which unleash_integration doesn't support.", addon.Id, addon.Provider),
+		)
+	}
+}
+
+// sensitiveParametersHash hashes the sensitive parameters of the configured provider, so state can tell when they
+// change without storing them. It is unknown while any of them is.
+func (m *integrationResourceModel) sensitiveParametersHash(diagnostics *diag.Diagnostics) types.String {
+	sensitive := map[string]string{}
+	for attribute, block := range m.blocks() {
+		if block.IsUnknown() {
+			return types.StringUnknown()
+		}
+		if block.IsNull() {
+			continue
+		}
+
+		attributes := block.Attributes()
+		for _, parameter := range integrationProviderByAttribute(attribute).parameters {
+			value, ok := attributes[parameter.attribute].(types.String)
+			if !parameter.sensitive || !ok || value.IsNull() {
+				continue
+			}
+			if value.IsUnknown() {
+				return types.StringUnknown()
+			}
+			sensitive[parameter.name] = value.ValueString()
+		}
+	}
+
+	// maps are encoded with sorted keys, so equal parameters always hash the same
+	payload, err := json.Marshal(sensitive)
+	if err != nil {
+		diagnostics.AddError("Unable to hash sensitive integration parameters", err.Error())
+		return types.StringUnknown()
+	}
+	sum := sha256.Sum256(payload)
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
+
+func integrationProviderByAttribute(attribute string) integrationProvider {
+	for _, provider := range integrationProviders {
+		if provider.attribute == attribute {
+			return provider
+		}
+	}
+	return integrationProvider{}
+}
+
+func (p integrationProvider) attributeTypes() map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{}
+	for _, parameter := range p.parameters {
+		attributeTypes[parameter.attribute] = types.StringType
+	}
+	return attributeTypes
+}
+
+func (p integrationProvider) schemaAttribute() schema.SingleNestedAttribute {
+	attributes := map[string]schema.Attribute{}
+	for _, parameter := range p.parameters {
+		attributes[parameter.attribute] = schema.StringAttribute{
+			Description: parameter.description,
+			Required:    parameter.required,
+			Optional:    !parameter.required,
+			Sensitive:   parameter.sensitive,
+			WriteOnly:   parameter.sensitive,
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Description: p.description,
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+// flatten reads the parameters of an addon into the block of its provider. Sensitive parameters are write-only, so
+// they are always null, which is also all Unleash reveals of them.
+func (p integrationProvider) flatten(parameters map[string]json.RawMessage, diagnostics *diag.Diagnostics) types.Object {
+	values := map[string]attr.Value{}
+	for _, parameter := range p.parameters {
+		if parameter.sensitive {
+			values[parameter.attribute] = types.StringNull()
+			continue
+		}
+
+		if value, ok := addonParameterString(parameters[parameter.name]); ok {
+			values[parameter.attribute] = types.StringValue(value)
+		} else {
+			values[parameter.attribute] = types.StringNull()
+		}
+	}
+
+	object, diags := types.ObjectValue(p.attributeTypes(), values)
+	diagnostics.Append(diags...)
+	return object
+}
+
+func stringSliceValues(values []types.String) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, value.ValueString())
+	}
+	return result
+}
+
+func optionalStringValues(values []string) []types.String {
+	if len(values) == 0 {
+		return nil
+	}
+	return stringValues(values)
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_integration" "audit" {
+						events = ["feature-created", "feature-archived"]
+
+						webhook = {
+							url           = "https://audit.example.com/unleash"
+							authorization = "Bearer secret"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_integration.audit", "id"),
+					resource.TestCheckResourceAttr("unleash_integration.audit", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_integration.audit", "events.#", "2"),
+					resource.TestCheckNoResourceAttr("unleash_integration.audit", "projects"),
+					resource.TestCheckNoResourceAttr("unleash_integration.audit", "webhook.url"),
+					resource.TestCheckNoResourceAttr("unleash_integration.audit", "webhook.authorization"),
+					resource.TestCheckResourceAttrSet("unleash_integration.audit", "sensitive_parameters_hash"),
+					resource.TestCheckNoResourceAttr("unleash_integration.audit", "webhook.content_type"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_integration" "audit" {
+						description  = "Audit trail"
+						enabled      = false
+						events       = ["feature-created"]
+						projects     = ["default"]
+						environments = ["production"]
+
+						webhook = {
+							url           = "https://audit.example.com/unleash"
+							authorization = "Bearer rotated"
+							content_type  = "text/plain"
+							body_template = "{{event.type}} {{event.featureName}}"
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_integration.audit", "description", "Audit trail"),
+					resource.TestCheckResourceAttr("unleash_integration.audit", "enabled", "false"),
+					resource.TestCheckResourceAttr("unleash_integration.audit", "projects.#", "1"),
+					resource.TestCheckResourceAttr("unleash_integration.audit", "environments.#", "1"),
+					resource.TestCheckNoResourceAttr("unleash_integration.audit", "webhook.authorization"),
+					resource.TestCheckResourceAttr("unleash_integration.audit", "webhook.content_type", "text/plain"),
+				),
+			},
+			{
+				ResourceName:      "unleash_integration.audit",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Unleash never returns sensitive parameters, so nothing tells what they hash to
+				ImportStateVerifyIgnore: []string{"sensitive_parameters_hash"},
+			},
+		},
+	})
+}
+
+func TestAccIntegrationResourceRequiresOneProvider(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_integration" "invalid" {
+						events = ["feature-created"]
+
+						teams = {
+							url = "https://example.webhook.office.com/webhookb2/abc"
+						}
+
+						datadog = {
+							url     = "https://api.datadoghq.com/api/v1/events"
+							api_key = "secret"
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile("Exactly one of"),
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntegrationRoundTrip(t *testing.T) {
+	webhook := integrationProviderByAttribute("webhook")
+	block, diags := types.ObjectValue(webhook.attributeTypes(), map[string]attr.Value{
+		"url":            types.StringValue("https://audit.example.com/unleash"),
+		"content_type":   types.StringNull(),
+		"authorization":  types.StringValue("Bearer secret"),
+		"body_template":  types.StringValue(`{"event": "{{event.type}}"}`),
+		"custom_headers": types.StringNull(),
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	model := integrationResourceModel{
+		Enabled:  types.BoolValue(true),
+		Events:   []types.String{types.StringValue("feature-created")},
+		Webhook:  block,
+		SlackApp: types.ObjectNull(integrationProviderByAttribute("slack_app").attributeTypes()),
+		Teams:    types.ObjectNull(integrationProviderByAttribute("teams").attributeTypes()),
+		Datadog:  types.ObjectNull(integrationProviderByAttribute("datadog").attributeTypes()),
+		NewRelic: types.ObjectNull(integrationProviderByAttribute("new_relic").attributeTypes()),
+	}
+
+	var diagnostics diag.Diagnostics
+	addon := model.toApi(model, &diagnostics)
+	require.False(t, diagnostics.HasError(), "%v", diagnostics)
+	assert.Equal(t, "webhook", addon.Provider)
+	assert.Equal(t, map[string]json.RawMessage{
+		"url":           json.RawMessage(`"https://audit.example.com/unleash"`),
+		"authorization": json.RawMessage(`"Bearer secret"`),
+		"bodyTemplate":  json.RawMessage(`"{\"event\": \"{{event.type}}\"}"`),
+	}, addon.Parameters)
+	assert.Equal(t, []string{}, addon.Projects, "an unset filter is sent as an empty list")
+
+	// Unleash masks sensitive parameters in its responses
+	addon.Id = 7
+	addon.Parameters["url"] = json.RawMessage(`"*****"`)
+	addon.Parameters["authorization"] = json.RawMessage(`"*****"`)
+	model.hydrateFromApi(addon, &diagnostics)
+	require.False(t, diagnostics.HasError(), "%v", diagnostics)
+
+	assert.Equal(t, "7", model.Id.ValueString())
+	assert.Nil(t, model.Projects)
+	assert.True(t, model.SlackApp.IsNull())
+	attributes := model.Webhook.Attributes()
+	assert.True(t, attributes["url"].IsNull(), "sensitive parameters are write-only")
+	assert.True(t, attributes["authorization"].IsNull())
+	assert.Equal(t, types.StringValue(`{"event": "{{event.type}}"}`), attributes["body_template"])
+}
+
+func TestIntegrationSensitiveParametersHash(t *testing.T) {
+	webhook := integrationProviderByAttribute("webhook")
+	model := func(authorization attr.Value, bodyTemplate string) integrationResourceModel {
+		block, diags := types.ObjectValue(webhook.attributeTypes(), map[string]attr.Value{
+			"url":            types.StringValue("https://audit.example.com/unleash"),
+			"content_type":   types.StringNull(),
+			"authorization":  authorization,
+			"body_template":  types.StringValue(bodyTemplate),
+			"custom_headers": types.StringNull(),
+		})
+		require.False(t, diags.HasError(), "%v", diags)
+		return integrationResourceModel{
+			Webhook:  block,
+			SlackApp: types.ObjectNull(integrationProviderByAttribute("slack_app").attributeTypes()),
+			Teams:    types.ObjectNull(integrationProviderByAttribute("teams").attributeTypes()),
+			Datadog:  types.ObjectNull(integrationProviderByAttribute("datadog").attributeTypes()),
+			NewRelic: types.ObjectNull(integrationProviderByAttribute("new_relic").attributeTypes()),
+		}
+	}
+	hash := func(m integrationResourceModel) types.String {
+		var diags diag.Diagnostics
+		value := m.sensitiveParametersHash(&diags)
+		require.False(t, diags.HasError(), "%v", diags)
+		return value
+	}
+
+	original := hash(model(types.StringValue("Bearer secret"), "{{event.type}}"))
+	require.Len(t, original.ValueString(), 64)
+	assert.NotContains(t, original.ValueString(), "secret")
+	assert.Equal(t, original, hash(model(types.StringValue("Bearer secret"), "{{event.featureName}}")), "only sensitive parameters are hashed")
+	assert.NotEqual(t, original, hash(model(types.StringValue("Bearer rotated"), "{{event.type}}")))
+	assert.True(t, hash(model(types.StringUnknown(), "{{event.type}}")).IsUnknown())
+}
+
+func TestIntegrationHydrateUnsupportedProvider(t *testing.T) {
+	var model integrationResourceModel
+	var diagnostics diag.Diagnostics
+	model.hydrateFromApi(addonApiModel{Id: 3, Provider: "slack"}, &diagnostics)
+
+	require.True(t, diagnostics.HasError())
+	assert.Contains(t, diagnostics[0].Detail(), "slack provider")
+}
+
+func TestAddonParameterString(t *testing.T) {
+	value, ok := addonParameterString(json.RawMessage(`"application/json"`))
+	assert.True(t, ok)
+	assert.Equal(t, "application/json", value)
+
+	_, ok = addonParameterString(json.RawMessage(`""`))
+	assert.False(t, ok)
+
+	_, ok = addonParameterString(nil)
+	assert.False(t, ok)
+
+	value, ok = addonParameterString(json.RawMessage(`true`))
+	assert.True(t, ok)
+	assert.Equal(t, "true", value)
+}
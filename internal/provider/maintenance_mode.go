@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maintenanceModeChecks holds a maintenanceModeCheck for every configured client, so a plan reads the setting once
+// however many resources it changes.
+var maintenanceModeChecks sync.Map
+
+type maintenanceModeCheck struct {
+	once    sync.Once
+	enabled bool
+}
+
+// warnAboutMaintenanceMode adds a warning when the plan creates, updates or deletes the resource while maintenance
+// mode is on, since Unleash then rejects the change. Resources call it from ModifyPlan, except unleash_maintenance_mode
+// itself.
+func warnAboutMaintenanceMode(ctx context.Context, client *unleash.APIClient, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	if !maintenanceModeEnabled(ctx, client) {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Unleash is in maintenance mode",
+		"Unleash rejects changes while maintenance mode is on, so applying this change will fail. "+
+			"Turn maintenance mode off first. If unleash_maintenance_mode turns it off in the same apply, make this resource depend on it.",
+	)
+}
+
+// maintenanceModeEnabled reports whether maintenance mode is on, reading it once per client. Failing to find out, for
+// example because the token isn't allowed to read the setting, counts as off, since the warning is only a hint.
+func maintenanceModeEnabled(ctx context.Context, client *unleash.APIClient) bool {
+	value, _ := maintenanceModeChecks.LoadOrStore(client, &maintenanceModeCheck{})
+	check := value.(*maintenanceModeCheck)
+
+	check.once.Do(func() {
+		var maintenance maintenanceModeApiModel
+		httpRes, err := adminApiRequest(ctx, client, http.MethodGet, maintenanceModePath, nil, &maintenance)
+		if err != nil || httpRes == nil || httpRes.StatusCode != http.StatusOK {
+			tflog.Debug(ctx, "Unable to read maintenance mode, assuming it is off")
+			return
+		}
+		check.enabled = maintenance.Enabled
+	})
+	return check.enabled
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource              = &maintenanceModeResource{}
+	_ resource.ResourceWithConfigure = &maintenanceModeResource{}
+)
+
+const maintenanceModePath = "/api/admin/maintenance"
+
+func NewMaintenanceModeResource() resource.Resource {
+	return &maintenanceModeResource{}
+}
+
+type maintenanceModeResource struct {
+	client *unleash.APIClient
+}
+
+type maintenanceModeResourceModel struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+type maintenanceModeApiModel struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (r *maintenanceModeResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *maintenanceModeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_mode"
+}
+
+func (r *maintenanceModeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages maintenance mode, which makes Unleash reject changes while SDKs keep evaluating flags. " +
+			"There is only one maintenance mode per instance, so declare this resource at most once. Destroying it turns maintenance mode off. " +
+			"While maintenance mode is on, the provider warns about every other planned change, since Unleash will reject it.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether maintenance mode is on.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *maintenanceModeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create maintenance mode resource")
+	var plan maintenanceModeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.toggle(ctx, plan.Enabled.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating maintenance mode resource", map[string]any{"success": true})
+}
+
+func (r *maintenanceModeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read maintenance mode resource")
+	var state maintenanceModeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var maintenance maintenanceModeApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, maintenanceModePath, nil, &maintenance)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.Enabled = types.BoolValue(maintenance.Enabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading maintenance mode resource", map[string]any{"success": true})
+}
+
+func (r *maintenanceModeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update maintenance mode resource")
+	var plan maintenanceModeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.toggle(ctx, plan.Enabled.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating maintenance mode resource", map[string]any{"success": true})
+}
+
+func (r *maintenanceModeResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete maintenance mode resource")
+
+	if !r.toggle(ctx, false, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting maintenance mode resource", map[string]any{"success": true})
+}
+
+func (r *maintenanceModeResource) toggle(ctx context.Context, enabled bool, diagnostics *diag.Diagnostics) bool {
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, maintenanceModePath, maintenanceModeApiModel{Enabled: enabled}, nil)
+	return ValidateApiResponse(httpRes, 204, diagnostics, err)
+}
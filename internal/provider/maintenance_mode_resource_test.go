@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccCheckMaintenanceMode(t *testing.T, expected bool) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		var maintenance maintenanceModeApiModel
+		_, err := adminApiRequest(context.Background(), testAccClient(t), http.MethodGet, maintenanceModePath, nil, &maintenance)
+		if err != nil {
+			return err
+		}
+		if maintenance.Enabled != expected {
+			return fmt.Errorf("expected maintenance mode to be %t, got %t", expected, maintenance.Enabled)
+		}
+		return nil
+	}
+}
+
+func TestAccMaintenanceModeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMaintenanceMode(t, false),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_maintenance_mode" "this" {
+						enabled = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_maintenance_mode.this", "enabled", "true"),
+					testAccCheckMaintenanceMode(t, true),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_maintenance_mode" "this" {
+						enabled = false
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_maintenance_mode.this", "enabled", "false"),
+					testAccCheckMaintenanceMode(t, false),
+				),
+			},
+			{
+				// destroying the resource turns maintenance mode off again
+				Config: `
+					resource "unleash_maintenance_mode" "this" {
+						enabled = true
+					}
+				`,
+				Check: testAccCheckMaintenanceMode(t, true),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWarnAboutMaintenanceMode(t *testing.T) {
+	var requests int
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, maintenanceModePath, r.URL.Path)
+		_, _ = w.Write([]byte(`{"enabled": true}`))
+	})
+
+	ctx := context.Background()
+	banner := &bannerResource{client: client}
+	schemaResp := resource.SchemaResponse{}
+	banner.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	bannerValue := func(message string) tftypes.Value {
+		values := map[string]tftypes.Value{}
+		for name, attributeType := range objectType.(tftypes.Object).AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["message"] = tftypes.NewValue(tftypes.String, message)
+		return tftypes.NewValue(objectType, values)
+	}
+	plan := func(prior, planned tftypes.Value) resource.ModifyPlanResponse {
+		resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned}}
+		warnAboutMaintenanceMode(ctx, client, resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: prior},
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned},
+		}, &resp)
+		return resp
+	}
+	absent := tftypes.NewValue(objectType, nil)
+
+	assert.Empty(t, plan(bannerValue("Hello"), bannerValue("Hello")).Diagnostics, "nothing to write")
+	assert.Equal(t, 0, requests, "maintenance mode isn't read when nothing is written")
+
+	for name, resp := range map[string]resource.ModifyPlanResponse{
+		"create": plan(absent, bannerValue("Hello")),
+		"update": plan(bannerValue("Hello"), bannerValue("Goodbye")),
+		"delete": plan(bannerValue("Hello"), absent),
+	} {
+		require.Len(t, resp.Diagnostics, 1, name)
+		assert.Equal(t, "Unleash is in maintenance mode", resp.Diagnostics[0].Summary(), name)
+	}
+	assert.Equal(t, 1, requests, "maintenance mode is read once per client")
+}
+
+func TestMaintenanceModeEnabledWhenUnreadable(t *testing.T) {
+	client := testAdminApiClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	assert.False(t, maintenanceModeEnabled(context.Background(), client), "the warning is only a hint")
+}
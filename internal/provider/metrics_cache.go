@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+)
+
+// metricsCaches holds a metricsCache for every configured client, so the metrics data sources of one provider
+// configuration share what they fetched.
+var metricsCaches sync.Map
+
+// metricsCache keeps successful responses by request path, which names the project and feature. The window of
+// unleash_feature_metrics is applied to the cached buckets, so every window shares one request.
+type metricsCache struct {
+	lock    sync.Mutex
+	entries map[string]*metricsCacheEntry
+}
+
+type metricsCacheEntry struct {
+	lock     sync.Mutex
+	response *http.Response
+	body     json.RawMessage
+}
+
+// cachedAdminGet is adminApiRequest for GET requests whose responses can be reused while the provider runs. Only
+// successful responses are kept, so failed requests are tried again.
+func cachedAdminGet(ctx context.Context, client *unleash.APIClient, path string, result any) (*http.Response, error) {
+	value, _ := metricsCaches.LoadOrStore(client, &metricsCache{entries: map[string]*metricsCacheEntry{}})
+	cache := value.(*metricsCache)
+
+	cache.lock.Lock()
+	entry, ok := cache.entries[path]
+	if !ok {
+		entry = &metricsCacheEntry{}
+		cache.entries[path] = entry
+	}
+	cache.lock.Unlock()
+
+	// requests for the same path wait for the first one instead of all being sent
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.response == nil {
+		var body json.RawMessage
+		httpRes, err := adminApiRequest(ctx, client, http.MethodGet, path, nil, &body)
+		if err != nil || httpRes == nil || httpRes.StatusCode != http.StatusOK {
+			return httpRes, err
+		}
+		entry.response = httpRes
+		entry.body = body
+	}
+
+	if result != nil && len(entry.body) > 0 {
+		if err := json.Unmarshal(entry.body, result); err != nil {
+			return entry.response, err
+		}
+	}
+	return entry.response, nil
+}
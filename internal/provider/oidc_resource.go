@@ -15,8 +15,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &oidcResource{}
-	_ resource.ResourceWithConfigure = &oidcResource{}
+	_ resource.Resource               = &oidcResource{}
+	_ resource.ResourceWithConfigure  = &oidcResource{}
+	_ resource.ResourceWithModifyPlan = &oidcResource{}
 )
 
 func NewOidcResource() resource.Resource {
@@ -114,6 +115,10 @@ func (r *oidcResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Debug(ctx, "OIDC configuration read")
 }
 
+func (r *oidcResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
 func (r *oidcResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "Preparing to create OIDC configuration")
 	var plan oidcResourceModel
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &passwordAuthResource{}
+	_ resource.ResourceWithConfigure  = &passwordAuthResource{}
+	_ resource.ResourceWithModifyPlan = &passwordAuthResource{}
+)
+
+const passwordAuthPath = "/api/admin/auth/simple/settings"
+
+func NewPasswordAuthResource() resource.Resource {
+	return &passwordAuthResource{}
+}
+
+type passwordAuthResource struct {
+	client *unleash.APIClient
+}
+
+type passwordAuthResourceModel struct {
+	Enabled             types.Bool `tfsdk:"enabled"`
+	SingleSignOnEnabled types.Bool `tfsdk:"single_sign_on_enabled"`
+}
+
+type passwordAuthApiModel struct {
+	Disabled bool `json:"disabled"`
+}
+
+type singleSignOnSettingsApiModel struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (r *passwordAuthResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *passwordAuthResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_auth"
+}
+
+func (r *passwordAuthResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages whether users can log in with a username and password. " +
+			"There is only one password authentication setting per instance, so declare this resource at most once. Destroying it enables password login again. " +
+			"To avoid locking everyone out, password login can only be disabled while OIDC or SAML is enabled.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether users can log in with a username and password.",
+				Required:    true,
+			},
+			"single_sign_on_enabled": schema.BoolAttribute{
+				Description: "Set this to the `enabled` attribute of an `unleash_oidc` or `unleash_saml` resource to disable password login in the same apply that enables single sign-on. " +
+					"It only orders the changes and postpones the check to the apply: password login is disabled only once Unleash reports OIDC or SAML as enabled.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// ModifyPlan refuses to disable password login unless single sign-on is enabled in Unleash, since nobody could log in
+// otherwise. When single_sign_on_enabled says another resource enables it in the same apply, the check waits for the
+// apply, where it is made again before password login is disabled.
+func (r *passwordAuthResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) || r.client == nil {
+		return
+	}
+
+	var plan passwordAuthResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Enabled.IsUnknown() || plan.Enabled.ValueBool() || plan.SingleSignOnEnabled.IsUnknown() || plan.SingleSignOnEnabled.ValueBool() {
+		return
+	}
+
+	r.requireSingleSignOn(ctx, &resp.Diagnostics)
+}
+
+func (r *passwordAuthResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create password auth resource")
+	var plan passwordAuthResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Enabled.ValueBool() && !r.requireSingleSignOn(ctx, &resp.Diagnostics) {
+		return
+	}
+	if !r.setEnabled(ctx, plan.Enabled.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating password auth resource", map[string]any{"success": true})
+}
+
+func (r *passwordAuthResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read password auth resource")
+	var state passwordAuthResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings passwordAuthApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, passwordAuthPath, nil, &settings)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.Enabled = types.BoolValue(!settings.Disabled)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading password auth resource", map[string]any{"success": true})
+}
+
+func (r *passwordAuthResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update password auth resource")
+	var plan passwordAuthResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Enabled.ValueBool() && !r.requireSingleSignOn(ctx, &resp.Diagnostics) {
+		return
+	}
+	if !r.setEnabled(ctx, plan.Enabled.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating password auth resource", map[string]any{"success": true})
+}
+
+func (r *passwordAuthResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete password auth resource")
+
+	if !r.setEnabled(ctx, true, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting password auth resource", map[string]any{"success": true})
+}
+
+func (r *passwordAuthResource) setEnabled(ctx context.Context, enabled bool, diagnostics *diag.Diagnostics) bool {
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, passwordAuthPath, passwordAuthApiModel{Disabled: !enabled}, nil)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+// requireSingleSignOn reports whether OIDC or SAML is enabled in Unleash, adding an error if neither is. Older versions
+// and editions of Unleash without an OIDC or SAML endpoint count as not having it enabled.
+func (r *passwordAuthResource) requireSingleSignOn(ctx context.Context, diagnostics *diag.Diagnostics) bool {
+	for _, provider := range []string{"oidc", "saml"} {
+		var settings singleSignOnSettingsApiModel
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, "/api/admin/auth/"+provider+"/settings", nil, &settings)
+		if isNotFoundResponse(httpRes) {
+			continue
+		}
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return false
+		}
+		if settings.Enabled {
+			return true
+		}
+	}
+
+	diagnostics.AddAttributeError(
+		path.Root("enabled"),
+		"Password login can't be disabled",
+		"Neither OIDC nor SAML is enabled, so disabling password login would lock every user out of Unleash. "+
+			"Enable OIDC or SAML first, or set single_sign_on_enabled to the enabled attribute of the unleash_oidc or unleash_saml resource that enables it.",
+	)
+	return false
+}
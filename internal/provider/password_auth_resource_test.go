@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPasswordAuthResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_password_auth" "password" {
+						enabled = false
+					}
+				`,
+				ExpectError: regexp.MustCompile(`would lock every user out of Unleash`),
+			},
+			{
+				Config: `
+					resource "unleash_password_auth" "password" {
+						enabled = true
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("unleash_password_auth.password", "enabled", "true"),
+			},
+		},
+	})
+}
+
+func TestAccPasswordAuthResourceWithSingleSignOn(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_oidc" "sso" {
+						enabled      = true
+						discover_url = "http://mock-openid-server:9000/.well-known/openid-configuration"
+						secret       = "super-secret"
+						client_id    = "client-id"
+					}
+
+					resource "unleash_password_auth" "password" {
+						enabled                = false
+						single_sign_on_enabled = unleash_oidc.sso.enabled
+					}
+				`,
+				Check: resource.TestCheckResourceAttr("unleash_password_auth.password", "enabled", "false"),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func planPasswordAuth(t *testing.T, settings map[string]string, enabled bool, singleSignOnEnabled any) resource.ModifyPlanResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, ok := settings[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	})
+
+	passwordAuth := &passwordAuthResource{client: client}
+	schemaResp := resource.SchemaResponse{}
+	passwordAuth.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	planned := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"enabled":                tftypes.NewValue(tftypes.Bool, enabled),
+		"single_sign_on_enabled": tftypes.NewValue(tftypes.Bool, singleSignOnEnabled),
+	})}
+	resp := resource.ModifyPlanResponse{Plan: planned}
+	passwordAuth.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Plan:  planned,
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}, &resp)
+	return resp
+}
+
+func TestPasswordAuthModifyPlan(t *testing.T) {
+	disabledEverywhere := map[string]string{
+		"/api/admin/auth/oidc/settings": `{"enabled": false}`,
+		"/api/admin/auth/saml/settings": `{"enabled": false}`,
+	}
+
+	resp := planPasswordAuth(t, disabledEverywhere, false, nil)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Password login can't be disabled", resp.Diagnostics[0].Summary())
+
+	assert.True(t, planPasswordAuth(t, map[string]string{}, false, nil).Diagnostics.HasError(), "single sign-on is off when Unleash doesn't support it")
+	assert.False(t, planPasswordAuth(t, disabledEverywhere, true, nil).Diagnostics.HasError(), "enabling is always allowed")
+	assert.False(t, planPasswordAuth(t, disabledEverywhere, false, true).Diagnostics.HasError(), "single sign-on is enabled in the same configuration")
+	assert.False(t, planPasswordAuth(t, disabledEverywhere, false, tftypes.UnknownValue).Diagnostics.HasError(), "unknown until apply")
+	assert.False(t, planPasswordAuth(t, map[string]string{
+		"/api/admin/auth/oidc/settings": `{"enabled": false}`,
+		"/api/admin/auth/saml/settings": `{"enabled": true}`,
+	}, false, nil).Diagnostics.HasError(), "SAML is enabled in Unleash")
+}
+
+func TestPasswordAuthCreateChecksSingleSignOnBeforeDisabling(t *testing.T) {
+	ctx := context.Background()
+	oidcEnabled := false
+	disabled := false
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/admin/auth/oidc/settings":
+			_, _ = w.Write([]byte(`{"enabled": ` + strconv.FormatBool(oidcEnabled) + `}`))
+		case "/api/admin/auth/saml/settings":
+			_, _ = w.Write([]byte(`{"enabled": false}`))
+		case passwordAuthPath:
+			disabled = true
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	passwordAuth := &passwordAuthResource{client: client}
+	schemaResp := resource.SchemaResponse{}
+	passwordAuth.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	create := func() resource.CreateResponse {
+		// single_sign_on_enabled claims OIDC is enabled, but only Unleash decides
+		planned := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"enabled":                tftypes.NewValue(tftypes.Bool, false),
+			"single_sign_on_enabled": tftypes.NewValue(tftypes.Bool, true),
+		})}
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		passwordAuth.Create(ctx, resource.CreateRequest{Plan: planned}, &resp)
+		return resp
+	}
+
+	resp := create()
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Password login can't be disabled", resp.Diagnostics[0].Summary())
+	assert.False(t, disabled, "password login must stay enabled")
+
+	oidcEnabled = true
+	resp = create()
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, disabled)
+}
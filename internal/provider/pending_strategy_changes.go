@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"sync"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+)
+
+// pendingStrategyChanges holds the feature environments whose strategies the current plan changes, per configured
+// client. Strategy promotions planned after the resources making those changes can't tell yet what they will promote.
+var pendingStrategyChanges sync.Map
+
+type strategyEnvironmentKey struct {
+	client      *unleash.APIClient
+	project     string
+	feature     string
+	environment string
+}
+
+// planStrategyChanges records that the plan changes the strategies of a feature in an environment. Resources that write
+// strategies call it from ModifyPlan.
+func planStrategyChanges(client *unleash.APIClient, project string, feature string, environment string) {
+	if client == nil {
+		return
+	}
+	pendingStrategyChanges.Store(strategyEnvironmentKey{client, project, feature, environment}, true)
+}
+
+// hasPendingStrategyChanges reports whether a resource planned before this one changes the strategies of a feature in
+// an environment.
+func hasPendingStrategyChanges(client *unleash.APIClient, project string, feature string, environment string) bool {
+	_, ok := pendingStrategyChanges.Load(strategyEnvironmentKey{client, project, feature, environment})
+	return ok
+}
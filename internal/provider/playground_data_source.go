@@ -0,0 +1,457 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &playgroundDataSource{}
+	_ datasource.DataSourceWithConfigure = &playgroundDataSource{}
+)
+
+func NewPlaygroundDataSource() datasource.DataSource {
+	return &playgroundDataSource{}
+}
+
+type playgroundDataSource struct {
+	client *unleash.APIClient
+}
+
+type playgroundDataSourceModel struct {
+	Environment types.String                      `tfsdk:"environment"`
+	Projects    []types.String                    `tfsdk:"projects"`
+	Context     *playgroundContextModel           `tfsdk:"context"`
+	Features    map[string]playgroundFeatureModel `tfsdk:"features"`
+}
+
+type playgroundContextModel struct {
+	AppName       types.String `tfsdk:"app_name"`
+	UserId        types.String `tfsdk:"user_id"`
+	SessionId     types.String `tfsdk:"session_id"`
+	RemoteAddress types.String `tfsdk:"remote_address"`
+	CurrentTime   types.String `tfsdk:"current_time"`
+	Properties    types.Map    `tfsdk:"properties"`
+}
+
+type playgroundFeatureModel struct {
+	Project                  types.String              `tfsdk:"project"`
+	Enabled                  types.Bool                `tfsdk:"enabled"`
+	EnabledInEnvironment     types.Bool                `tfsdk:"enabled_in_environment"`
+	HasUnsatisfiedDependency types.Bool                `tfsdk:"has_unsatisfied_dependency"`
+	Variant                  *playgroundVariantModel   `tfsdk:"variant"`
+	StrategiesResult         types.String              `tfsdk:"strategies_result"`
+	Strategies               []playgroundStrategyModel `tfsdk:"strategies"`
+}
+
+type playgroundVariantModel struct {
+	Name    types.String         `tfsdk:"name"`
+	Enabled types.Bool           `tfsdk:"enabled"`
+	Payload *variantPayloadModel `tfsdk:"payload"`
+}
+
+type playgroundStrategyModel struct {
+	Id               types.String                `tfsdk:"id"`
+	Name             types.String                `tfsdk:"name"`
+	Title            types.String                `tfsdk:"title"`
+	Disabled         types.Bool                  `tfsdk:"disabled"`
+	Result           types.String                `tfsdk:"result"`
+	EvaluationStatus types.String                `tfsdk:"evaluation_status"`
+	Constraints      []playgroundConstraintModel `tfsdk:"constraints"`
+	Segments         []playgroundSegmentModel    `tfsdk:"segments"`
+}
+
+type playgroundConstraintModel struct {
+	ContextName types.String `tfsdk:"context_name"`
+	Operator    types.String `tfsdk:"operator"`
+	Result      types.Bool   `tfsdk:"result"`
+}
+
+type playgroundSegmentModel struct {
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Result types.Bool   `tfsdk:"result"`
+}
+
+type playgroundRequestApiModel struct {
+	Environment string                    `json:"environment"`
+	Projects    any                       `json:"projects"`
+	Context     playgroundContextApiModel `json:"context"`
+}
+
+type playgroundContextApiModel struct {
+	AppName       string            `json:"appName"`
+	Environment   string            `json:"environment"`
+	UserId        string            `json:"userId,omitempty"`
+	SessionId     string            `json:"sessionId,omitempty"`
+	RemoteAddress string            `json:"remoteAddress,omitempty"`
+	CurrentTime   string            `json:"currentTime,omitempty"`
+	Properties    map[string]string `json:"properties,omitempty"`
+}
+
+type playgroundResponseApiModel struct {
+	Features []playgroundFeatureApiModel `json:"features"`
+}
+
+type playgroundFeatureApiModel struct {
+	Name                          string                     `json:"name"`
+	ProjectId                     string                     `json:"projectId"`
+	IsEnabled                     bool                       `json:"isEnabled"`
+	IsEnabledInCurrentEnvironment bool                       `json:"isEnabledInCurrentEnvironment"`
+	HasUnsatisfiedDependency      bool                       `json:"hasUnsatisfiedDependency"`
+	Variant                       *playgroundVariantApiModel `json:"variant"`
+	Strategies                    struct {
+		Result json.RawMessage              `json:"result"`
+		Data   []playgroundStrategyApiModel `json:"data"`
+	} `json:"strategies"`
+}
+
+type playgroundVariantApiModel struct {
+	Name    string                  `json:"name"`
+	Enabled bool                    `json:"enabled"`
+	Payload *variantPayloadApiModel `json:"payload"`
+}
+
+type playgroundStrategyApiModel struct {
+	Id       string  `json:"id"`
+	Name     string  `json:"name"`
+	Title    *string `json:"title"`
+	Disabled *bool   `json:"disabled"`
+	Result   struct {
+		EvaluationStatus string          `json:"evaluationStatus"`
+		Enabled          json.RawMessage `json:"enabled"`
+	} `json:"result"`
+	Constraints []playgroundConstraintApiModel `json:"constraints"`
+	Segments    []struct {
+		Id     int64  `json:"id"`
+		Name   string `json:"name"`
+		Result bool   `json:"result"`
+	} `json:"segments"`
+}
+
+type playgroundConstraintApiModel struct {
+	ContextName string `json:"contextName"`
+	Operator    string `json:"operator"`
+	Result      bool   `json:"result"`
+}
+
+func (d *playgroundDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *playgroundDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_playground"
+}
+
+func (d *playgroundDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluate feature flags for a context with the Unleash playground, the way an SDK would. Useful in `check` blocks to assert how flags are targeted.",
+		Attributes: map[string]schema.Attribute{
+			"environment": schema.StringAttribute{
+				Description: "The environment to evaluate the features in.",
+				Required:    true,
+			},
+			"projects": schema.ListAttribute{
+				Description: "The projects whose features are evaluated. Evaluates the features of every project if not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"context": schema.SingleNestedAttribute{
+				Description: "The Unleash context to evaluate the features with.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"app_name": schema.StringAttribute{
+						Description: "The name of the application. Defaults to `terraform-provider-unleash`.",
+						Optional:    true,
+					},
+					"user_id": schema.StringAttribute{
+						Description: "An identifier for the current user.",
+						Optional:    true,
+					},
+					"session_id": schema.StringAttribute{
+						Description: "An identifier for the current session.",
+						Optional:    true,
+					},
+					"remote_address": schema.StringAttribute{
+						Description: "The IP address of the client.",
+						Optional:    true,
+					},
+					"current_time": schema.StringAttribute{
+						Description: "The time to evaluate the features at, in RFC 3339 format. Defaults to now.",
+						Optional:    true,
+					},
+					"properties": schema.MapAttribute{
+						Description: "Custom context fields, keyed by name.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"features": schema.MapNestedAttribute{
+				Description: "The evaluated features, keyed by feature name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"project": schema.StringAttribute{
+							Description: "The project of the feature.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the feature is enabled for the context.",
+							Computed:    true,
+						},
+						"enabled_in_environment": schema.BoolAttribute{
+							Description: "Whether the feature is turned on in the environment at all.",
+							Computed:    true,
+						},
+						"has_unsatisfied_dependency": schema.BoolAttribute{
+							Description: "Whether the feature depends on a parent feature that isn't satisfied for the context.",
+							Computed:    true,
+						},
+						"variant": schema.SingleNestedAttribute{
+							Description: "The variant handed out for the context. Its name is `disabled` when the feature is disabled or has no variants.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "The name of the variant.",
+									Computed:    true,
+								},
+								"enabled": schema.BoolAttribute{
+									Description: "Whether the variant is enabled.",
+									Computed:    true,
+								},
+								"payload": schema.SingleNestedAttribute{
+									Description: "The payload of the variant.",
+									Computed:    true,
+									Attributes: map[string]schema.Attribute{
+										"type": schema.StringAttribute{
+											Description: "The type of the payload.",
+											Computed:    true,
+										},
+										"value": schema.StringAttribute{
+											Description: "The payload value.",
+											Computed:    true,
+										},
+									},
+								},
+							},
+						},
+						"strategies_result": schema.StringAttribute{
+							Description: "The combined result of the strategies: `true`, `false`, or `unknown` when a strategy can't be evaluated and the rest are `false`.",
+							Computed:    true,
+						},
+						"strategies": schema.ListNestedAttribute{
+							Description: "How each strategy evaluated.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "The id of the strategy.",
+										Computed:    true,
+									},
+									"name": schema.StringAttribute{
+										Description: "The name of the strategy.",
+										Computed:    true,
+									},
+									"title": schema.StringAttribute{
+										Description: "The title of the strategy.",
+										Computed:    true,
+									},
+									"disabled": schema.BoolAttribute{
+										Description: "Whether the strategy is disabled. Disabled strategies aren't evaluated.",
+										Computed:    true,
+									},
+									"result": schema.StringAttribute{
+										Description: "The result of the strategy: `true`, `false`, or `unknown` for strategies Unleash can't evaluate, like custom strategies.",
+										Computed:    true,
+									},
+									"evaluation_status": schema.StringAttribute{
+										Description: "`complete` when the strategy was evaluated, `incomplete` or `unevaluated` otherwise.",
+										Computed:    true,
+									},
+									"constraints": schema.ListNestedAttribute{
+										Description: "How each constraint of the strategy evaluated.",
+										Computed:    true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"context_name": schema.StringAttribute{
+													Description: "The context field the constraint checks.",
+													Computed:    true,
+												},
+												"operator": schema.StringAttribute{
+													Description: "The operator of the constraint.",
+													Computed:    true,
+												},
+												"result": schema.BoolAttribute{
+													Description: "Whether the constraint matched.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+									"segments": schema.ListNestedAttribute{
+										Description: "How each segment of the strategy evaluated.",
+										Computed:    true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"id": schema.StringAttribute{
+													Description: "The id of the segment.",
+													Computed:    true,
+												},
+												"name": schema.StringAttribute{
+													Description: "The name of the segment.",
+													Computed:    true,
+												},
+												"result": schema.BoolAttribute{
+													Description: "Whether the segment matched.",
+													Computed:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *playgroundDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read playground data source")
+	var state playgroundDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := playgroundRequestApiModel{
+		Environment: state.Environment.ValueString(),
+		Projects:    "*",
+		Context: playgroundContextApiModel{
+			AppName:     terraformProviderAppName(),
+			Environment: state.Environment.ValueString(),
+		},
+	}
+
+	if state.Projects != nil {
+		projects := make([]string, 0, len(state.Projects))
+		for _, project := range state.Projects {
+			projects = append(projects, project.ValueString())
+		}
+		request.Projects = projects
+	}
+
+	if state.Context != nil {
+		if !state.Context.AppName.IsNull() {
+			request.Context.AppName = state.Context.AppName.ValueString()
+		}
+		request.Context.UserId = state.Context.UserId.ValueString()
+		request.Context.SessionId = state.Context.SessionId.ValueString()
+		request.Context.RemoteAddress = state.Context.RemoteAddress.ValueString()
+		request.Context.CurrentTime = state.Context.CurrentTime.ValueString()
+		if !state.Context.Properties.IsNull() {
+			resp.Diagnostics.Append(state.Context.Properties.ElementsAs(ctx, &request.Context.Properties, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	var playground playgroundResponseApiModel
+	httpRes, err := adminApiRequest(ctx, d.client, http.MethodPost, "/api/admin/playground", request, &playground)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.Features = make(map[string]playgroundFeatureModel, len(playground.Features))
+	for _, feature := range playground.Features {
+		state.Features[feature.Name] = flattenPlaygroundFeature(feature)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading playground data source", map[string]any{"success": true})
+}
+
+func flattenPlaygroundFeature(feature playgroundFeatureApiModel) playgroundFeatureModel {
+	model := playgroundFeatureModel{
+		Project:                  types.StringValue(feature.ProjectId),
+		Enabled:                  types.BoolValue(feature.IsEnabled),
+		EnabledInEnvironment:     types.BoolValue(feature.IsEnabledInCurrentEnvironment),
+		HasUnsatisfiedDependency: types.BoolValue(feature.HasUnsatisfiedDependency),
+		StrategiesResult:         types.StringValue(playgroundResult(feature.Strategies.Result)),
+		Strategies:               make([]playgroundStrategyModel, 0, len(feature.Strategies.Data)),
+	}
+
+	if feature.Variant != nil {
+		model.Variant = &playgroundVariantModel{
+			Name:    types.StringValue(feature.Variant.Name),
+			Enabled: types.BoolValue(feature.Variant.Enabled),
+		}
+		if feature.Variant.Payload != nil {
+			model.Variant.Payload = &variantPayloadModel{
+				Type:  types.StringValue(feature.Variant.Payload.Type),
+				Value: types.StringValue(feature.Variant.Payload.Value),
+			}
+		}
+	}
+
+	for _, strategy := range feature.Strategies.Data {
+		strategyModel := playgroundStrategyModel{
+			Id:               types.StringValue(strategy.Id),
+			Name:             types.StringValue(strategy.Name),
+			Title:            types.StringPointerValue(strategy.Title),
+			Disabled:         types.BoolValue(strategy.Disabled != nil && *strategy.Disabled),
+			Result:           types.StringValue(playgroundResult(strategy.Result.Enabled)),
+			EvaluationStatus: types.StringValue(strategy.Result.EvaluationStatus),
+			Constraints:      make([]playgroundConstraintModel, 0, len(strategy.Constraints)),
+			Segments:         make([]playgroundSegmentModel, 0, len(strategy.Segments)),
+		}
+		for _, constraint := range strategy.Constraints {
+			strategyModel.Constraints = append(strategyModel.Constraints, playgroundConstraintModel{
+				ContextName: types.StringValue(constraint.ContextName),
+				Operator:    types.StringValue(constraint.Operator),
+				Result:      types.BoolValue(constraint.Result),
+			})
+		}
+		for _, segment := range strategy.Segments {
+			strategyModel.Segments = append(strategyModel.Segments, playgroundSegmentModel{
+				Id:     types.StringValue(strconv.FormatInt(segment.Id, 10)),
+				Name:   types.StringValue(segment.Name),
+				Result: types.BoolValue(segment.Result),
+			})
+		}
+		model.Strategies = append(model.Strategies, strategyModel)
+	}
+
+	return model
+}
+
+// playgroundResult turns a result that is either a boolean or the string "unknown" into a string.
+func playgroundResult(result json.RawMessage) string {
+	var enabled bool
+	if err := json.Unmarshal(result, &enabled); err == nil {
+		return strconv.FormatBool(enabled)
+	}
+	return "unknown"
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPlaygroundDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-playground-on")
+			testAccCreateFeature(t, "default", "tf-playground-off")
+			testAccEnableFeature(t, "default", "tf-playground-on", "development")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unleash_playground" "tenant" {
+						environment = "development"
+						projects    = ["default"]
+						context = {
+							user_id = "42"
+							properties = {
+								tenant = "x"
+							}
+						}
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_playground.tenant", "features.tf-playground-on.project", "default"),
+					resource.TestCheckResourceAttr("data.unleash_playground.tenant", "features.tf-playground-on.enabled", "true"),
+					resource.TestCheckResourceAttr("data.unleash_playground.tenant", "features.tf-playground-on.strategies_result", "true"),
+					resource.TestCheckResourceAttr("data.unleash_playground.tenant", "features.tf-playground-off.enabled", "false"),
+					resource.TestCheckResourceAttr("data.unleash_playground.tenant", "features.tf-playground-off.enabled_in_environment", "false"),
+					resource.TestCheckResourceAttr("data.unleash_playground.tenant", "features.tf-playground-off.variant.name", "disabled"),
+				),
+			},
+		},
+	})
+}
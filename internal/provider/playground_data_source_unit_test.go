@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenPlaygroundFeature(t *testing.T) {
+	var feature playgroundFeatureApiModel
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"name": "beta",
+		"projectId": "default",
+		"isEnabled": false,
+		"isEnabledInCurrentEnvironment": true,
+		"hasUnsatisfiedDependency": false,
+		"variant": {"name": "disabled", "enabled": false},
+		"variants": [],
+		"strategies": {
+			"result": "unknown",
+			"data": [
+				{
+					"id": "1",
+					"name": "flexibleRollout",
+					"disabled": null,
+					"result": {"evaluationStatus": "complete", "enabled": false},
+					"constraints": [{"contextName": "tenant", "operator": "IN", "values": ["x"], "result": false}],
+					"segments": [{"id": 7, "name": "beta-testers", "result": true, "constraints": []}],
+					"parameters": {},
+					"links": {}
+				},
+				{
+					"id": "2",
+					"name": "custom",
+					"title": "Legacy",
+					"disabled": false,
+					"result": {"evaluationStatus": "incomplete", "enabled": "unknown"},
+					"constraints": [],
+					"segments": [],
+					"parameters": {},
+					"links": {}
+				}
+			]
+		}
+	}`), &feature))
+
+	model := flattenPlaygroundFeature(feature)
+	assert.Equal(t, "default", model.Project.ValueString())
+	assert.False(t, model.Enabled.ValueBool())
+	assert.True(t, model.EnabledInEnvironment.ValueBool())
+	assert.Equal(t, "unknown", model.StrategiesResult.ValueString())
+	assert.Equal(t, "disabled", model.Variant.Name.ValueString())
+	assert.Nil(t, model.Variant.Payload)
+
+	require.Len(t, model.Strategies, 2)
+	assert.Equal(t, "false", model.Strategies[0].Result.ValueString())
+	assert.Equal(t, "complete", model.Strategies[0].EvaluationStatus.ValueString())
+	assert.True(t, model.Strategies[0].Title.IsNull())
+	assert.False(t, model.Strategies[0].Disabled.ValueBool())
+	require.Len(t, model.Strategies[0].Constraints, 1)
+	assert.Equal(t, "tenant", model.Strategies[0].Constraints[0].ContextName.ValueString())
+	assert.False(t, model.Strategies[0].Constraints[0].Result.ValueBool())
+	require.Len(t, model.Strategies[0].Segments, 1)
+	assert.Equal(t, "7", model.Strategies[0].Segments[0].Id.ValueString())
+	assert.Equal(t, "unknown", model.Strategies[1].Result.ValueString())
+	assert.Equal(t, "Legacy", model.Strategies[1].Title.ValueString())
+}
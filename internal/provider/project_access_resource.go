@@ -17,6 +17,7 @@ var (
 	_ resource.Resource                = &projectAccessResource{}
 	_ resource.ResourceWithConfigure   = &projectAccessResource{}
 	_ resource.ResourceWithImportState = &projectAccessResource{}
+	_ resource.ResourceWithModifyPlan  = &projectAccessResource{}
 )
 
 func NewProjectAccessResource() resource.Resource {
@@ -93,6 +94,10 @@ func (r *projectAccessResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (r *projectAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
 func (r *projectAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Preparing to import projectAccess resource")
 
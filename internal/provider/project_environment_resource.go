@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                = &projectEnvironmentResource{}
 	_ resource.ResourceWithConfigure   = &projectEnvironmentResource{}
 	_ resource.ResourceWithImportState = &projectEnvironmentResource{}
+	_ resource.ResourceWithModifyPlan  = &projectEnvironmentResource{}
 )
 
 func NewProjectEnvironmentResource() resource.Resource {
@@ -108,6 +109,10 @@ func (r *projectEnvironmentResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+func (r *projectEnvironmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
 func (r *projectEnvironmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Preparing to import project environment resource")
 
@@ -316,7 +321,7 @@ func (r *projectEnvironmentResource) configureProjectEnvironment(ctx context.Con
 }
 
 func (r *projectEnvironmentResource) hydrateState(ctx context.Context, state *projectEnvironmentResourceModel, diagnostics *diag.Diagnostics) bool {
-	enabled, ok := r.projectEnvironmentIsEnabled(ctx, state.ProjectId.ValueString(), state.EnvironmentName.ValueString(), diagnostics)
+	enabled, ok := projectEnvironmentIsEnabled(ctx, r.client, state.ProjectId.ValueString(), state.EnvironmentName.ValueString(), diagnostics)
 	if !ok {
 		return false
 	}
@@ -337,8 +342,10 @@ func (r *projectEnvironmentResource) hydrateState(ctx context.Context, state *pr
 	return r.hydrateManagedChangeRequestState(ctx, state, diagnostics)
 }
 
-func (r *projectEnvironmentResource) projectEnvironmentIsEnabled(ctx context.Context, projectId string, envName string, diagnostics *diag.Diagnostics) (bool, bool) {
-	environments, getEnvironmentsResponse, getEnvironmentsErr := r.client.EnvironmentsAPI.GetProjectEnvironments(ctx, projectId).Execute()
+// projectEnvironmentIsEnabled reports whether an environment is enabled in a project. The second result is false when
+// the project couldn't be read.
+func projectEnvironmentIsEnabled(ctx context.Context, client *unleash.APIClient, projectId string, envName string, diagnostics *diag.Diagnostics) (bool, bool) {
+	environments, getEnvironmentsResponse, getEnvironmentsErr := client.EnvironmentsAPI.GetProjectEnvironments(ctx, projectId).Execute()
 	if !ValidateApiResponse(getEnvironmentsResponse, 200, diagnostics, getEnvironmentsErr) {
 		return false, false
 	}
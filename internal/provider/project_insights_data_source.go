@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &projectInsightsDataSource{}
+	_ datasource.DataSourceWithConfigure = &projectInsightsDataSource{}
+)
+
+func NewProjectInsightsDataSource() datasource.DataSource {
+	return &projectInsightsDataSource{}
+}
+
+type projectInsightsDataSource struct {
+	client *unleash.APIClient
+}
+
+type projectInsightsDataSourceModel struct {
+	Project               types.String           `tfsdk:"project"`
+	Health                types.Int64            `tfsdk:"health"`
+	TechnicalDebt         types.Int64            `tfsdk:"technical_debt"`
+	Members               types.Int64            `tfsdk:"members"`
+	LeadTimeDays          types.Float64          `tfsdk:"lead_time_days"`
+	FeatureCount          types.Int64            `tfsdk:"feature_count"`
+	ActiveCount           types.Int64            `tfsdk:"active_count"`
+	StaleCount            types.Int64            `tfsdk:"stale_count"`
+	PotentiallyStaleCount types.Int64            `tfsdk:"potentially_stale_count"`
+	FlagTypeCounts        map[string]types.Int64 `tfsdk:"flag_type_counts"`
+	StaleFlags            []types.String         `tfsdk:"stale_flags"`
+	PotentiallyStaleFlags []types.String         `tfsdk:"potentially_stale_flags"`
+}
+
+type projectOverviewApiModel struct {
+	Health            *int64                            `json:"health"`
+	TechnicalDebt     *int64                            `json:"technicalDebt"`
+	Members           int64                             `json:"members"`
+	FeatureTypeCounts []projectFeatureTypeCountApiModel `json:"featureTypeCounts"`
+	Stats             struct {
+		AvgTimeToProdCurrentWindow float64 `json:"avgTimeToProdCurrentWindow"`
+	} `json:"stats"`
+}
+
+type projectFeatureTypeCountApiModel struct {
+	Type  string `json:"type"`
+	Count int64  `json:"count"`
+}
+
+// projectFlagStates splits the flags of a project into the ones marked as stale, the ones Unleash considers
+// potentially stale because they outlived the expected lifetime of their type, and the remaining active ones.
+type projectFlagStates struct {
+	Active           []string
+	Stale            []string
+	PotentiallyStale []string
+}
+
+func (d *projectInsightsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *projectInsightsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_insights"
+}
+
+func (d *projectInsightsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch health and flag lifecycle insights of a project, such as its health score and which flags are stale.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The id of the project.",
+				Required:    true,
+			},
+			"health": schema.Int64Attribute{
+				Description: "The health score of the project, from 0 to 100. The share of flags that are neither stale nor potentially stale.",
+				Computed:    true,
+			},
+			"technical_debt": schema.Int64Attribute{
+				Description: "The technical debt of the project, from 0 to 100. Null on Unleash versions that don't report it.",
+				Computed:    true,
+			},
+			"members": schema.Int64Attribute{
+				Description: "The number of members of the project.",
+				Computed:    true,
+			},
+			"lead_time_days": schema.Float64Attribute{
+				Description: "The average number of days from creating a flag to enabling it in production, over the last 30 days.",
+				Computed:    true,
+			},
+			"feature_count": schema.Int64Attribute{
+				Description: "The number of flags in the project.",
+				Computed:    true,
+			},
+			"active_count": schema.Int64Attribute{
+				Description: "The number of flags that are neither stale nor potentially stale.",
+				Computed:    true,
+			},
+			"stale_count": schema.Int64Attribute{
+				Description: "The number of flags marked as stale.",
+				Computed:    true,
+			},
+			"potentially_stale_count": schema.Int64Attribute{
+				Description: "The number of flags that outlived the expected lifetime of their type but aren't marked as stale.",
+				Computed:    true,
+			},
+			"flag_type_counts": schema.MapAttribute{
+				Description: "The number of flags of each type, keyed by type.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"stale_flags": schema.ListAttribute{
+				Description: "The names of the flags marked as stale.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"potentially_stale_flags": schema.ListAttribute{
+				Description: "The names of the flags that are potentially stale.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *projectInsightsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read project insights data source")
+	var state projectInsightsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	project := state.Project.ValueString()
+
+	var overview projectOverviewApiModel
+	httpRes, err := adminApiRequest(ctx, d.client, http.MethodGet, "/api/admin/projects/"+url.PathEscape(project)+"/overview", nil, &overview)
+	if isNotFoundResponse(httpRes) {
+		resp.Diagnostics.AddError("Project not found", "Project "+project+" doesn't exist.")
+		return
+	}
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	features, ok := searchFeatures(ctx, d.client, url.Values{"project": {"IS:" + project}}, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	potentiallyStale, ok := searchFeatures(ctx, d.client, url.Values{
+		"project": {"IS:" + project},
+		"state":   {"IS:potentially-stale"},
+	}, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	states := splitProjectFlagStates(features, potentiallyStale)
+
+	state.TechnicalDebt = types.Int64PointerValue(overview.TechnicalDebt)
+	state.Health = types.Int64PointerValue(overview.Health)
+	if overview.Health == nil && overview.TechnicalDebt != nil {
+		state.Health = types.Int64Value(100 - *overview.TechnicalDebt)
+	}
+	state.Members = types.Int64Value(overview.Members)
+	state.LeadTimeDays = types.Float64Value(overview.Stats.AvgTimeToProdCurrentWindow)
+	state.FeatureCount = types.Int64Value(int64(len(features)))
+	state.ActiveCount = types.Int64Value(int64(len(states.Active)))
+	state.StaleCount = types.Int64Value(int64(len(states.Stale)))
+	state.PotentiallyStaleCount = types.Int64Value(int64(len(states.PotentiallyStale)))
+	state.StaleFlags = stringValues(states.Stale)
+	state.PotentiallyStaleFlags = stringValues(states.PotentiallyStale)
+	state.FlagTypeCounts = map[string]types.Int64{}
+	for _, count := range overview.FeatureTypeCounts {
+		state.FlagTypeCounts[count.Type] = types.Int64Value(count.Count)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading project insights data source", map[string]any{"success": true})
+}
+
+// splitProjectFlagStates sorts the flags of a project by state. Flags marked as stale count as stale even when
+// Unleash also reports them as potentially stale.
+func splitProjectFlagStates(features []featureSearchApiModel, potentiallyStale []featureSearchApiModel) projectFlagStates {
+	flagged := map[string]bool{}
+	for _, feature := range potentiallyStale {
+		flagged[feature.Name] = true
+	}
+
+	states := projectFlagStates{Active: []string{}, Stale: []string{}, PotentiallyStale: []string{}}
+	for _, feature := range features {
+		switch {
+		case feature.Stale:
+			states.Stale = append(states.Stale, feature.Name)
+		case flagged[feature.Name]:
+			states.PotentiallyStale = append(states.PotentiallyStale, feature.Name)
+		default:
+			states.Active = append(states.Active, feature.Name)
+		}
+	}
+	slices.Sort(states.Active)
+	slices.Sort(states.Stale)
+	slices.Sort(states.PotentiallyStale)
+
+	return states
+}
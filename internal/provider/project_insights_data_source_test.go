@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectInsightsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-insights")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "unleash_project_insights" "default" {
+						project = "default"
+					}
+
+					output "counts_add_up" {
+						value = (
+							data.unleash_project_insights.default.active_count +
+							data.unleash_project_insights.default.stale_count +
+							data.unleash_project_insights.default.potentially_stale_count
+						) == data.unleash_project_insights.default.feature_count
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("counts_add_up", "true"),
+					resource.TestCheckResourceAttrSet("data.unleash_project_insights.default", "health"),
+					resource.TestCheckResourceAttrSet("data.unleash_project_insights.default", "members"),
+					resource.TestCheckResourceAttrSet("data.unleash_project_insights.default", "flag_type_counts.release"),
+				),
+			},
+		},
+	})
+}
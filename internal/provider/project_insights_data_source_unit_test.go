@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitProjectFlagStates(t *testing.T) {
+	features := []featureSearchApiModel{
+		{Name: "search"},
+		{Name: "legacy-checkout", Stale: true},
+		{Name: "dark-mode"},
+		{Name: "old-banner", Stale: true},
+	}
+	potentiallyStale := []featureSearchApiModel{
+		{Name: "dark-mode"},
+		{Name: "old-banner", Stale: true},
+	}
+
+	states := splitProjectFlagStates(features, potentiallyStale)
+
+	assert.Equal(t, []string{"search"}, states.Active)
+	assert.Equal(t, []string{"legacy-checkout", "old-banner"}, states.Stale, "stale flags aren't counted twice")
+	assert.Equal(t, []string{"dark-mode"}, states.PotentiallyStale)
+
+	empty := splitProjectFlagStates(nil, nil)
+	assert.NotNil(t, empty.Stale)
+	assert.Empty(t, empty.Stale)
+}
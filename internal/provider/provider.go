@@ -237,6 +237,28 @@ func (p *UnleashProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewContextFieldResource,
 		NewEnvironmentResource,
 		NewProjectEnvironmentResource,
+		NewSegmentResource,
+		NewStrategyResource,
+		NewFeatureResource,
+		NewFeatureDependencyResource,
+		NewTagTypeResource,
+		NewFeatureTagsResource,
+		NewFeatureLinkResource,
+		NewReleasePlanTemplateResource,
+		NewFeatureReleasePlanResource,
+		NewFeatureStrategyPromotionResource,
+		NewEnvironmentKillSwitchResource,
+		NewFeatureImportResource,
+		NewFeatureManifestResource,
+		NewIntegrationResource,
+		NewBannerResource,
+		NewMaintenanceModeResource,
+		NewSignalEndpointResource,
+		NewActionSetResource,
+		NewPublicSignupTokenResource,
+		NewFrontendSettingsResource,
+		NewPasswordAuthResource,
+		NewScimResource,
 	}
 }
 
@@ -250,6 +272,18 @@ func (p *UnleashProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewContextFieldDataSource,
 		NewEnvironmentDataSource,
 		NewProjectEnvironmentDataSource,
+		NewSegmentDataSource,
+		NewStrategiesDataSource,
+		NewTagTypeDataSource,
+		NewTagsDataSource,
+		NewReleasePlanTemplateDataSource,
+		NewFeatureExportDataSource,
+		NewPlaygroundDataSource,
+		NewFeatureMetricsDataSource,
+		NewUnusedFeaturesDataSource,
+		NewProjectInsightsDataSource,
+		NewFeaturesDataSource,
+		NewIntegrationProvidersDataSource,
 	}
 }
 
@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &publicSignupTokenResource{}
+	_ resource.ResourceWithConfigure      = &publicSignupTokenResource{}
+	_ resource.ResourceWithImportState    = &publicSignupTokenResource{}
+	_ resource.ResourceWithModifyPlan     = &publicSignupTokenResource{}
+	_ resource.ResourceWithValidateConfig = &publicSignupTokenResource{}
+)
+
+func NewPublicSignupTokenResource() resource.Resource {
+	return &publicSignupTokenResource{}
+}
+
+type publicSignupTokenResource struct {
+	client *unleash.APIClient
+}
+
+type publicSignupTokenResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Role      types.String `tfsdk:"role"`
+	Url       types.String `tfsdk:"url"`
+	UserCount types.Int64  `tfsdk:"user_count"`
+}
+
+type publicSignupTokenApiModel struct {
+	Secret    string                          `json:"secret"`
+	Url       *string                         `json:"url"`
+	Name      string                          `json:"name"`
+	Enabled   bool                            `json:"enabled"`
+	ExpiresAt string                          `json:"expiresAt"`
+	Role      publicSignupTokenRoleApiModel   `json:"role"`
+	Users     []publicSignupTokenUserApiModel `json:"users"`
+}
+
+type publicSignupTokenRoleApiModel struct {
+	Name string `json:"name"`
+}
+
+type publicSignupTokenUserApiModel struct {
+	Id int64 `json:"id"`
+}
+
+type publicSignupTokenCreateApiModel struct {
+	Name      string `json:"name"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+type publicSignupTokenUpdateApiModel struct {
+	ExpiresAt string `json:"expiresAt"`
+	Enabled   bool   `json:"enabled"`
+}
+
+func (r *publicSignupTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *publicSignupTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_public_signup_token"
+}
+
+func (r *publicSignupTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a public signup token, an invite link that lets anyone with the link create an account. " +
+			"Unleash can't delete invite links, so destroying this resource disables the link instead.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The secret of the token, which is part of the invite link.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the token, only shown in the UI. Changing it creates a new invite link.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "When the invite link expires, as an RFC3339 timestamp. Unleash allows at most a month from when the link is created or updated.",
+				Required:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the invite link can be used. Defaults to true. Unleash always reports expired links as disabled.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"role": schema.StringAttribute{
+				Description: "The root role given to users who sign up through the invite link. Unleash always gives them the Viewer role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "The invite link to send to new users.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_count": schema.Int64Attribute{
+				Description: "How many users signed up through the invite link.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *publicSignupTokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var expiresAt types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if resp.Diagnostics.HasError() || expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, expiresAt.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_at"),
+			"Invalid expiry",
+			fmt.Sprintf("expires_at must be an RFC3339 timestamp such as 2024-01-31T00:00:00Z, got %q.", expiresAt.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan rejects expiries Unleash would silently cap at a month from now. Only changed expiries are checked, since
+// the month counts from when the invite link was created or updated.
+func (r *publicSignupTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expires_at"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("expires_at"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() || planned.IsUnknown() || planned.Equal(prior) {
+		return
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, planned.ValueString())
+	if err == nil && expiresAt.After(time.Now().AddDate(0, 1, 0)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_at"),
+			"Invalid expiry",
+			fmt.Sprintf("Unleash only allows invite links to expire up to a month from now, but expires_at is %s.", planned.ValueString()),
+		)
+	}
+}
+
+func (r *publicSignupTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import public signup token resource")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, "Finished importing public signup token resource", map[string]any{"success": true})
+}
+
+func (r *publicSignupTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create public signup token resource")
+	var plan publicSignupTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expiresAt, ok := plan.expiry(&resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	var token publicSignupTokenApiModel
+	create := publicSignupTokenCreateApiModel{Name: plan.Name.ValueString(), ExpiresAt: expiresAt}
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/invite-link/tokens", create, &token)
+	if !ValidateApiResponse(httpRes, 201, &resp.Diagnostics, err) {
+		return
+	}
+
+	// new invite links are always enabled
+	if !plan.Enabled.ValueBool() {
+		update := publicSignupTokenUpdateApiModel{ExpiresAt: expiresAt, Enabled: false}
+		httpRes, err = adminApiRequest(ctx, r.client, http.MethodPut, publicSignupTokenPath(token.Secret), update, &token)
+		if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+	}
+
+	plan.hydrateFromApi(token)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating public signup token resource", map[string]any{"success": true})
+}
+
+func (r *publicSignupTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read public signup token resource")
+	var state publicSignupTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var token publicSignupTokenApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, publicSignupTokenPath(state.Id.ValueString()), nil, &token)
+	if isNotFoundResponse(httpRes) {
+		tflog.Warn(ctx, fmt.Sprintf("Public signup token with id %s not found, removing from state", state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.hydrateFromApi(token)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading public signup token resource", map[string]any{"success": true})
+}
+
+func (r *publicSignupTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update public signup token resource")
+	var plan publicSignupTokenResourceModel
+	var state publicSignupTokenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = state.Id
+
+	expiresAt, ok := plan.expiry(&resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	var token publicSignupTokenApiModel
+	update := publicSignupTokenUpdateApiModel{ExpiresAt: expiresAt, Enabled: plan.Enabled.ValueBool()}
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, publicSignupTokenPath(plan.Id.ValueString()), update, &token)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(token)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating public signup token resource", map[string]any{"success": true})
+}
+
+func (r *publicSignupTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete public signup token resource")
+	var state publicSignupTokenResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unleash has no endpoint to delete invite links, so disable it instead
+	update := publicSignupTokenUpdateApiModel{ExpiresAt: state.ExpiresAt.ValueString(), Enabled: false}
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, publicSignupTokenPath(state.Id.ValueString()), update, nil)
+	if !isNotFoundResponse(httpRes) && !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting public signup token resource", map[string]any{"success": true})
+}
+
+// expiry formats expires_at the way Unleash expects it.
+func (m *publicSignupTokenResourceModel) expiry(diagnostics *diag.Diagnostics) (string, bool) {
+	expiresAt, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid expiry", err.Error())
+		return "", false
+	}
+	return expiresAt.UTC().Format(time.RFC3339), true
+}
+
+func (m *publicSignupTokenResourceModel) hydrateFromApi(token publicSignupTokenApiModel) {
+	m.Id = types.StringValue(token.Secret)
+	m.Name = types.StringValue(token.Name)
+	m.Role = types.StringValue(token.Role.Name)
+	m.Url = optionalStringValue(token.Url)
+	m.UserCount = types.Int64Value(int64(len(token.Users)))
+
+	// expired links are reported as disabled, which is not something to undo
+	expiresAt, err := time.Parse(time.RFC3339, token.ExpiresAt)
+	if err != nil || time.Now().Before(expiresAt) || m.Enabled.IsNull() {
+		m.Enabled = types.BoolValue(token.Enabled)
+	}
+
+	// keep the configured timestamp when it is the same instant, since Unleash formats it with milliseconds
+	configured, err := time.Parse(time.RFC3339, m.ExpiresAt.ValueString())
+	if err != nil || !configured.Equal(expiresAt) {
+		m.ExpiresAt = types.StringValue(token.ExpiresAt)
+	}
+}
+
+func publicSignupTokenPath(secret string) string {
+	return "/api/admin/invite-link/tokens/" + url.PathEscape(secret)
+}
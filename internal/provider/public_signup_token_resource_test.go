@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func makePublicSignupTokenDef(expiresAt time.Time, enabled bool) string {
+	return fmt.Sprintf(`
+		resource "unleash_public_signup_token" "contractors" {
+			name       = "tf-contractors"
+			expires_at = "%s"
+			enabled    = %t
+		}
+	`, expiresAt.UTC().Format(time.RFC3339), enabled)
+}
+
+func TestAccPublicSignupTokenResource(t *testing.T) {
+	nextWeek := time.Now().AddDate(0, 0, 7).Truncate(time.Second)
+	nextTwoWeeks := time.Now().AddDate(0, 0, 14).Truncate(time.Second)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      makePublicSignupTokenDef(time.Now().AddDate(0, 2, 0), true),
+				ExpectError: regexp.MustCompile(`only allows invite links to expire up to a month from now`),
+			},
+			{
+				Config: makePublicSignupTokenDef(nextWeek, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_public_signup_token.contractors", "id"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.contractors", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.contractors", "role", "Viewer"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.contractors", "user_count", "0"),
+					resource.TestMatchResourceAttr("unleash_public_signup_token.contractors", "url", regexp.MustCompile(`invite=`)),
+				),
+			},
+			{
+				Config: makePublicSignupTokenDef(nextTwoWeeks, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_public_signup_token.contractors", "enabled", "false"),
+					resource.TestCheckResourceAttr("unleash_public_signup_token.contractors", "expires_at", nextTwoWeeks.UTC().Format(time.RFC3339)),
+				),
+			},
+			{
+				ResourceName:            "unleash_public_signup_token.contractors",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"expires_at"},
+			},
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublicSignupTokenHydrateFromApi(t *testing.T) {
+	link := "https://unleash.example.com/new-user?invite=secret"
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	model := publicSignupTokenResourceModel{
+		ExpiresAt: types.StringValue(expiresAt.Format(time.RFC3339)),
+		Enabled:   types.BoolValue(true),
+	}
+	model.hydrateFromApi(publicSignupTokenApiModel{
+		Secret:    "secret",
+		Url:       &link,
+		Name:      "contractors",
+		Enabled:   true,
+		ExpiresAt: expiresAt.Format("2006-01-02T15:04:05.000Z"),
+		Role:      publicSignupTokenRoleApiModel{Name: "Viewer"},
+		Users:     []publicSignupTokenUserApiModel{{Id: 1}, {Id: 2}},
+	})
+
+	assert.Equal(t, "secret", model.Id.ValueString())
+	assert.Equal(t, link, model.Url.ValueString())
+	assert.Equal(t, "Viewer", model.Role.ValueString())
+	assert.Equal(t, int64(2), model.UserCount.ValueInt64())
+	assert.Equal(t, expiresAt.Format(time.RFC3339), model.ExpiresAt.ValueString(), "the configured timestamp is kept")
+}
+
+func TestPublicSignupTokenHydrateFromApiExpired(t *testing.T) {
+	expiresAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second).Format(time.RFC3339)
+
+	model := publicSignupTokenResourceModel{ExpiresAt: types.StringValue(expiresAt), Enabled: types.BoolValue(true)}
+	model.hydrateFromApi(publicSignupTokenApiModel{Secret: "secret", ExpiresAt: expiresAt, Enabled: false})
+	assert.True(t, model.Enabled.ValueBool(), "an expired link doesn't count as disabled")
+	assert.True(t, model.Url.IsNull())
+
+	imported := publicSignupTokenResourceModel{}
+	imported.hydrateFromApi(publicSignupTokenApiModel{Secret: "secret", ExpiresAt: expiresAt, Enabled: false})
+	assert.False(t, imported.Enabled.ValueBool())
+	assert.Equal(t, expiresAt, imported.ExpiresAt.ValueString())
+}
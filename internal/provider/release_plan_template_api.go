@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"net/url"
+	"sort"
+)
+
+type releasePlanTemplateApiModel struct {
+	Id          string                         `json:"id,omitempty"`
+	Name        string                         `json:"name"`
+	Description *string                        `json:"description,omitempty"`
+	Milestones  []releasePlanMilestoneApiModel `json:"milestones"`
+	ArchivedAt  *string                        `json:"archivedAt,omitempty"`
+}
+
+type releasePlanMilestoneApiModel struct {
+	Id         string                      `json:"id,omitempty"`
+	Name       string                      `json:"name"`
+	SortOrder  int64                       `json:"sortOrder"`
+	StartedAt  *string                     `json:"startedAt,omitempty"`
+	Strategies []milestoneStrategyApiModel `json:"strategies"`
+}
+
+// milestoneStrategyApiModel is a featureStrategyApiModel as release plans store it, under strategyName instead of name.
+type milestoneStrategyApiModel struct {
+	Id           string                    `json:"id,omitempty"`
+	StrategyName string                    `json:"strategyName"`
+	Title        *string                   `json:"title,omitempty"`
+	SortOrder    int64                     `json:"sortOrder"`
+	Parameters   map[string]string         `json:"parameters"`
+	Constraints  []constraintApiModel      `json:"constraints"`
+	Variants     []strategyVariantApiModel `json:"variants"`
+	Segments     []int64                   `json:"segments"`
+}
+
+func releasePlanTemplatePath(id string) string {
+	return "/api/admin/release-plan-templates/" + url.PathEscape(id)
+}
+
+func toMilestoneStrategies(strategies []featureStrategyApiModel) []milestoneStrategyApiModel {
+	result := make([]milestoneStrategyApiModel, 0, len(strategies))
+	for i, strategy := range strategies {
+		result = append(result, milestoneStrategyApiModel{
+			StrategyName: strategy.Name,
+			Title:        strategy.Title,
+			SortOrder:    int64(i),
+			Parameters:   strategy.Parameters,
+			Constraints:  strategy.Constraints,
+			Variants:     strategy.Variants,
+			Segments:     strategy.Segments,
+		})
+	}
+	return result
+}
+
+func fromMilestoneStrategies(strategies []milestoneStrategyApiModel) []featureStrategyApiModel {
+	sorted := append([]milestoneStrategyApiModel{}, strategies...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SortOrder < sorted[j].SortOrder })
+
+	result := make([]featureStrategyApiModel, 0, len(sorted))
+	for _, strategy := range sorted {
+		sortOrder := strategy.SortOrder
+		result = append(result, featureStrategyApiModel{
+			Id:          strategy.Id,
+			Name:        strategy.StrategyName,
+			Title:       strategy.Title,
+			SortOrder:   &sortOrder,
+			Parameters:  strategy.Parameters,
+			Constraints: strategy.Constraints,
+			Variants:    strategy.Variants,
+			Segments:    strategy.Segments,
+		})
+	}
+	return result
+}
+
+func sortMilestones(milestones []releasePlanMilestoneApiModel) []releasePlanMilestoneApiModel {
+	sorted := append([]releasePlanMilestoneApiModel{}, milestones...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SortOrder < sorted[j].SortOrder })
+	return sorted
+}
+
+// featureReleasePlanApiModel is a release plan template applied to a feature in one environment.
+type featureReleasePlanApiModel struct {
+	Id                    string                         `json:"id"`
+	Name                  string                         `json:"name"`
+	ReleasePlanTemplateId string                         `json:"releasePlanTemplateId"`
+	ActiveMilestoneId     *string                        `json:"activeMilestoneId,omitempty"`
+	Milestones            []releasePlanMilestoneApiModel `json:"milestones"`
+}
+
+type releasePlanTemplateIdApiModel struct {
+	TemplateId string `json:"templateId"`
+}
+
+func featureReleasePlansPath(project string, feature string, environment string) string {
+	return featureEnvironmentPath(project, feature, environment) + "/release-plans"
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &releasePlanTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &releasePlanTemplateDataSource{}
+)
+
+func NewReleasePlanTemplateDataSource() datasource.DataSource {
+	return &releasePlanTemplateDataSource{}
+}
+
+type releasePlanTemplateDataSource struct {
+	client *unleash.APIClient
+}
+
+func (d *releasePlanTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *releasePlanTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release_plan_template"
+}
+
+func (d *releasePlanTemplateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a release plan template by name. Release plan templates are only available in Unleash Enterprise.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the release plan template.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the release plan template.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the release plan template.",
+				Computed:    true,
+			},
+			"milestones": schema.ListNestedAttribute{
+				Description: "The milestones of the release plan, in the order they are rolled out.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The id of the milestone.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the milestone.",
+							Computed:    true,
+						},
+						"strategies": featureStrategiesDataSourceAttribute("The strategies a feature uses while the milestone is active."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *releasePlanTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read release plan template data source")
+	var state releasePlanTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var templates []releasePlanTemplateApiModel
+	httpRes, err := adminApiRequest(ctx, d.client, http.MethodGet, "/api/admin/release-plan-templates", nil, &templates)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	var found *releasePlanTemplateApiModel
+	for i, template := range templates {
+		if template.Name == state.Name.ValueString() && template.ArchivedAt == nil {
+			found = &templates[i]
+			break
+		}
+	}
+	if found == nil {
+		resp.Diagnostics.AddError(
+			"Release plan template not found",
+			fmt.Sprintf("No release plan template named %s exists.", state.Name.ValueString()),
+		)
+		return
+	}
+
+	// read the template itself, the same way the resource does
+	var template releasePlanTemplateApiModel
+	httpRes, err = adminApiRequest(ctx, d.client, http.MethodGet, releasePlanTemplatePath(found.Id), nil, &template)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.hydrateFromApi(ctx, template, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading release plan template data source", map[string]any{"success": true})
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReleasePlanTemplateDataSource(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_release_plan_template" "rollout" {
+						name        = "tf-lookup-rollout"
+						description = "Looked up by name"
+						milestones = [
+							{
+								name = "Everyone"
+								strategies = [
+									{
+										name = "default"
+									}
+								]
+							}
+						]
+					}
+
+					data "unleash_release_plan_template" "rollout" {
+						name = unleash_release_plan_template.rollout.name
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.unleash_release_plan_template.rollout", "id", "unleash_release_plan_template.rollout", "id"),
+					resource.TestCheckResourceAttr("data.unleash_release_plan_template.rollout", "description", "Looked up by name"),
+					resource.TestCheckResourceAttr("data.unleash_release_plan_template.rollout", "milestones.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_release_plan_template.rollout", "milestones.0.strategies.0.name", "default"),
+				),
+			},
+		},
+	})
+}
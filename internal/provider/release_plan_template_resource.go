@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &releasePlanTemplateResource{}
+	_ resource.ResourceWithConfigure   = &releasePlanTemplateResource{}
+	_ resource.ResourceWithImportState = &releasePlanTemplateResource{}
+	_ resource.ResourceWithModifyPlan  = &releasePlanTemplateResource{}
+)
+
+func NewReleasePlanTemplateResource() resource.Resource {
+	return &releasePlanTemplateResource{}
+}
+
+type releasePlanTemplateResource struct {
+	client *unleash.APIClient
+}
+
+type releasePlanTemplateResourceModel struct {
+	Id          types.String                `tfsdk:"id"`
+	Name        types.String                `tfsdk:"name"`
+	Description types.String                `tfsdk:"description"`
+	Milestones  []releasePlanMilestoneModel `tfsdk:"milestones"`
+}
+
+type releasePlanMilestoneModel struct {
+	Id         types.String           `tfsdk:"id"`
+	Name       types.String           `tfsdk:"name"`
+	Strategies []featureStrategyModel `tfsdk:"strategies"`
+}
+
+func (r *releasePlanTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *releasePlanTemplateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release_plan_template"
+}
+
+func (r *releasePlanTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a release plan template: an ordered list of milestones, each with the strategies a feature uses while the milestone is active. Release plan templates are only available in Unleash Enterprise.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the release plan template.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the release plan template.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the release plan template.",
+				Optional:    true,
+			},
+			"milestones": schema.ListNestedAttribute{
+				Description: "The milestones of the release plan, in the order they are rolled out.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The id of the milestone.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the milestone.",
+							Required:    true,
+						},
+						"strategies": featureStrategiesResourceAttribute("The strategies a feature uses while the milestone is active."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *releasePlanTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *releasePlanTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import release plan template resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	tflog.Debug(ctx, "Finished importing release plan template resource", map[string]any{"success": true})
+}
+
+func (r *releasePlanTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create release plan template resource")
+	var plan releasePlanTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.toApi(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var template releasePlanTemplateApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/release-plan-templates", request, &template)
+	if !IsValidApiResponse(httpRes, []int{200, 201}, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(ctx, template, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating release plan template resource", map[string]any{"success": true})
+}
+
+func (r *releasePlanTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read release plan template resource")
+	var state releasePlanTemplateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var template releasePlanTemplateApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, releasePlanTemplatePath(state.Id.ValueString()), nil, &template)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Id.ValueString(), "Release plan template") {
+		return
+	}
+
+	if template.ArchivedAt != nil {
+		tflog.Warn(ctx, "Release plan template "+state.Id.ValueString()+" was archived, removing from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.hydrateFromApi(ctx, template, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading release plan template resource", map[string]any{"success": true})
+}
+
+func (r *releasePlanTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update release plan template resource")
+	var plan releasePlanTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.toApi(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request.Id = plan.Id.ValueString()
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, releasePlanTemplatePath(plan.Id.ValueString()), request, nil)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	var template releasePlanTemplateApiModel
+	httpRes, err = adminApiRequest(ctx, r.client, http.MethodGet, releasePlanTemplatePath(plan.Id.ValueString()), nil, &template)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(ctx, template, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating release plan template resource", map[string]any{"success": true})
+}
+
+func (r *releasePlanTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete release plan template resource")
+	var state releasePlanTemplateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, releasePlanTemplatePath(state.Id.ValueString()), nil, nil)
+	if !isNotFoundResponse(httpRes) && !IsValidApiResponse(httpRes, []int{200, 204}, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting release plan template resource", map[string]any{"success": true})
+}
+
+func (m *releasePlanTemplateResourceModel) toApi(ctx context.Context, diagnostics *diag.Diagnostics) releasePlanTemplateApiModel {
+	template := releasePlanTemplateApiModel{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+		Milestones:  make([]releasePlanMilestoneApiModel, 0, len(m.Milestones)),
+	}
+
+	for i, milestone := range m.Milestones {
+		template.Milestones = append(template.Milestones, releasePlanMilestoneApiModel{
+			Name:       milestone.Name.ValueString(),
+			SortOrder:  int64(i),
+			Strategies: toMilestoneStrategies(expandFeatureStrategies(ctx, milestone.Strategies, diagnostics)),
+		})
+	}
+
+	return template
+}
+
+func (m *releasePlanTemplateResourceModel) hydrateFromApi(ctx context.Context, template releasePlanTemplateApiModel, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(template.Id)
+	m.Name = types.StringValue(template.Name)
+
+	if template.Description != nil && *template.Description != "" {
+		m.Description = types.StringValue(*template.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+
+	m.Milestones = flattenMilestones(ctx, m.Milestones, template.Milestones, diagnostics)
+}
+
+func flattenMilestones(ctx context.Context, current []releasePlanMilestoneModel, milestones []releasePlanMilestoneApiModel, diagnostics *diag.Diagnostics) []releasePlanMilestoneModel {
+	models := make([]releasePlanMilestoneModel, 0, len(milestones))
+	for i, milestone := range sortMilestones(milestones) {
+		var existing releasePlanMilestoneModel
+		if i < len(current) {
+			existing = current[i]
+		}
+
+		models = append(models, releasePlanMilestoneModel{
+			Id:         types.StringValue(milestone.Id),
+			Name:       types.StringValue(milestone.Name),
+			Strategies: flattenFeatureStrategies(ctx, existing.Strategies, fromMilestoneStrategies(milestone.Strategies), diagnostics),
+		})
+	}
+	return models
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReleasePlanTemplateResource(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_release_plan_template" "rollout" {
+						name = "tf-gradual-rollout"
+						milestones = [
+							{
+								name = "Internal"
+								strategies = [
+									{
+										name       = "flexibleRollout"
+										parameters = { rollout = "100", stickiness = "default", groupId = "{{featureName}}" }
+										constraints = [
+											{
+												context_name = "userId"
+												operator     = "IN"
+												values       = ["1", "2"]
+											}
+										]
+									}
+								]
+							},
+							{
+								name = "Everyone"
+								strategies = [
+									{
+										name       = "flexibleRollout"
+										parameters = { rollout = "100", stickiness = "default", groupId = "{{featureName}}" }
+									}
+								]
+							}
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_release_plan_template.rollout", "id"),
+					resource.TestCheckResourceAttr("unleash_release_plan_template.rollout", "milestones.#", "2"),
+					resource.TestCheckResourceAttr("unleash_release_plan_template.rollout", "milestones.0.name", "Internal"),
+					resource.TestCheckResourceAttrSet("unleash_release_plan_template.rollout", "milestones.0.id"),
+					resource.TestCheckResourceAttr("unleash_release_plan_template.rollout", "milestones.0.strategies.0.constraints.0.values.#", "2"),
+					resource.TestCheckResourceAttr("unleash_release_plan_template.rollout", "milestones.1.strategies.0.parameters.rollout", "100"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_release_plan_template" "rollout" {
+						name        = "tf-gradual-rollout"
+						description = "Internal users, then half, then everyone"
+						milestones = [
+							{
+								name = "Internal"
+								strategies = [
+									{
+										name       = "flexibleRollout"
+										parameters = { rollout = "100", stickiness = "default", groupId = "{{featureName}}" }
+										constraints = [
+											{
+												context_name = "userId"
+												operator     = "IN"
+												values       = ["1", "2"]
+											}
+										]
+									}
+								]
+							},
+							{
+								name = "Half"
+								strategies = [
+									{
+										name       = "flexibleRollout"
+										parameters = { rollout = "50", stickiness = "default", groupId = "{{featureName}}" }
+									}
+								]
+							},
+							{
+								name = "Everyone"
+								strategies = [
+									{
+										name       = "flexibleRollout"
+										parameters = { rollout = "100", stickiness = "default", groupId = "{{featureName}}" }
+									}
+								]
+							}
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_release_plan_template.rollout", "description", "Internal users, then half, then everyone"),
+					resource.TestCheckResourceAttr("unleash_release_plan_template.rollout", "milestones.#", "3"),
+					resource.TestCheckResourceAttr("unleash_release_plan_template.rollout", "milestones.1.name", "Half"),
+					resource.TestCheckResourceAttr("unleash_release_plan_template.rollout", "milestones.1.strategies.0.parameters.rollout", "50"),
+				),
+			},
+			{
+				ResourceName:      "unleash_release_plan_template.rollout",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
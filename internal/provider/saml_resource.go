@@ -15,8 +15,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &samlResource{}
-	_ resource.ResourceWithConfigure = &samlResource{}
+	_ resource.Resource               = &samlResource{}
+	_ resource.ResourceWithConfigure  = &samlResource{}
+	_ resource.ResourceWithModifyPlan = &samlResource{}
 )
 
 func NewSamlResource() resource.Resource {
@@ -119,6 +120,10 @@ func (r *samlResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	tflog.Debug(ctx, "Finished reading SAML configuration")
 }
 
+func (r *samlResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
 func (r *samlResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	tflog.Debug(ctx, "Preparing to create SAML configuration")
 	var plan samlResourceModel
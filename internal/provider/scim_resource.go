@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &scimResource{}
+	_ resource.ResourceWithConfigure  = &scimResource{}
+	_ resource.ResourceWithModifyPlan = &scimResource{}
+)
+
+const scimSettingsPath = "/api/admin/scim-settings"
+
+func NewScimResource() resource.Resource {
+	return &scimResource{}
+}
+
+type scimResource struct {
+	client *unleash.APIClient
+}
+
+type scimResourceModel struct {
+	Enabled                      types.Bool   `tfsdk:"enabled"`
+	AssumeControlOfExistingUsers types.Bool   `tfsdk:"assume_control_of_existing_users"`
+	Token                        types.String `tfsdk:"token"`
+	RotateTokenTrigger           types.String `tfsdk:"rotate_token_trigger"`
+}
+
+type scimSettingsApiModel struct {
+	Enabled                 bool  `json:"enabled"`
+	AssumeControlOfExisting *bool `json:"assumeControlOfExisting,omitempty"`
+	HasToken                bool  `json:"hasToken,omitempty"`
+}
+
+type scimTokenApiModel struct {
+	Token string `json:"token"`
+}
+
+func (r *scimResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *scimResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim"
+}
+
+func (r *scimResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages SCIM provisioning, which lets an identity provider such as Okta create and update users and groups. " +
+			"There is only one SCIM configuration per instance, so declare this resource at most once. Destroying it disables SCIM provisioning. " +
+			"SCIM is an enterprise feature.",
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether SCIM provisioning is enabled.",
+				Required:    true,
+			},
+			"assume_control_of_existing_users": schema.BoolAttribute{
+				Description: "Whether SCIM takes over existing users with the same email instead of failing to provision them. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"token": schema.StringAttribute{
+				Description: "The token the identity provider authenticates with. Unleash only reveals it when it is generated.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_token_trigger": schema.StringAttribute{
+				Description: "Any value. Changing it generates a new token, which revokes the previous one.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan plans a new token when rotate_token_trigger changes, or when the token isn't known, for example because
+// Unleash lost it.
+func (r *scimResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state scimResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.RotateTokenTrigger.Equal(state.RotateTokenTrigger) || state.Token.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("token"), types.StringUnknown())...)
+	}
+}
+
+func (r *scimResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create scim resource")
+	var plan scimResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.setSettings(ctx, plan.toApi(), &resp.Diagnostics) {
+		return
+	}
+	plan.Token = r.generateToken(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		// without a token the identity provider can't use SCIM, so don't leave it enabled
+		r.setSettings(ctx, scimSettingsApiModel{Enabled: false}, &resp.Diagnostics)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating scim resource", map[string]any{"success": true})
+}
+
+func (r *scimResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read scim resource")
+	var state scimResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings scimSettingsApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, scimSettingsPath, nil, &settings)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.hydrateFromApi(settings)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading scim resource", map[string]any{"success": true})
+}
+
+func (r *scimResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update scim resource")
+	var plan, state scimResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.setSettings(ctx, plan.toApi(), &resp.Diagnostics) {
+		return
+	}
+	if plan.Token.IsUnknown() {
+		plan.Token = r.generateToken(ctx, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			// put the previous settings back so the state still describes Unleash
+			r.setSettings(ctx, state.toApi(), &resp.Diagnostics)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating scim resource", map[string]any{"success": true})
+}
+
+func (r *scimResource) Delete(ctx context.Context, _ resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete scim resource")
+
+	if !r.setSettings(ctx, scimSettingsApiModel{Enabled: false}, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting scim resource", map[string]any{"success": true})
+}
+
+func (r *scimResource) setSettings(ctx context.Context, settings scimSettingsApiModel, diagnostics *diag.Diagnostics) bool {
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, scimSettingsPath, settings, nil)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+func (r *scimResource) generateToken(ctx context.Context, diagnostics *diag.Diagnostics) types.String {
+	var token scimTokenApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, scimSettingsPath+"/generate-new-token", nil, &token)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return types.StringNull()
+	}
+	return types.StringValue(token.Token)
+}
+
+func (m *scimResourceModel) toApi() scimSettingsApiModel {
+	return scimSettingsApiModel{
+		Enabled:                 m.Enabled.ValueBool(),
+		AssumeControlOfExisting: m.AssumeControlOfExistingUsers.ValueBoolPointer(),
+	}
+}
+
+func (m *scimResourceModel) hydrateFromApi(settings scimSettingsApiModel) {
+	m.Enabled = types.BoolValue(settings.Enabled)
+	// older versions of Unleash don't report it
+	if settings.AssumeControlOfExisting != nil {
+		m.AssumeControlOfExistingUsers = types.BoolValue(*settings.AssumeControlOfExisting)
+	}
+	if !settings.HasToken {
+		m.Token = types.StringNull()
+	}
+}
+
+// scimManagedDescription describes the scim_managed attribute of users and groups.
+const scimManagedDescription = "Whether the %[1]s is provisioned through SCIM. The identity provider owns SCIM-managed %[1]ss, so Terraform refuses to change them."
+
+// refuseScimManagedChanges stops plans that would change a user or group provisioned through SCIM, since Unleash only
+// lets the identity provider change them and would reject the request.
+func refuseScimManagedChanges(ctx context.Context, kind string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var managed types.Bool
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("scim_managed"), &managed)...)
+	if resp.Diagnostics.HasError() || !managed.ValueBool() {
+		return
+	}
+
+	var id types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	action := "change"
+	if req.Plan.Raw.IsNull() {
+		action = "delete"
+	}
+	resp.Diagnostics.AddError(
+		"The "+kind+" is managed by SCIM",
+		"The "+kind+" with id "+id.ValueString()+" is provisioned through SCIM, so Unleash only lets the identity provider "+action+" it. "+
+			"Make the change in the identity provider and update the configuration to match, or stop managing the "+kind+" with Terraform using a removed block or terraform state rm.",
+	)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScimResource(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	var firstToken string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_scim" "okta" {
+						enabled = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_scim.okta", "enabled", "true"),
+					resource.TestCheckResourceAttr("unleash_scim.okta", "assume_control_of_existing_users", "false"),
+					resource.TestCheckResourceAttrWith("unleash_scim.okta", "token", func(value string) error {
+						if value == "" {
+							return fmt.Errorf("expected a token")
+						}
+						firstToken = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_scim" "okta" {
+						enabled                          = true
+						assume_control_of_existing_users = true
+						rotate_token_trigger             = "2024-06"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_scim.okta", "assume_control_of_existing_users", "true"),
+					resource.TestCheckResourceAttrWith("unleash_scim.okta", "token", func(value string) error {
+						if value == "" || value == firstToken {
+							return fmt.Errorf("expected a new token")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefuseScimManagedChanges(t *testing.T) {
+	ctx := context.Background()
+	user := &userResource{}
+	schemaResp := resource.SchemaResponse{}
+	user.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	userValue := func(name string, scimManaged bool) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":           tftypes.NewValue(tftypes.String, "7"),
+			"username":     tftypes.NewValue(tftypes.String, nil),
+			"email":        tftypes.NewValue(tftypes.String, "jane@example.com"),
+			"name":         tftypes.NewValue(tftypes.String, name),
+			"password":     tftypes.NewValue(tftypes.String, nil),
+			"root_role":    tftypes.NewValue(tftypes.Number, 3),
+			"send_email":   tftypes.NewValue(tftypes.Bool, false),
+			"scim_managed": tftypes.NewValue(tftypes.Bool, scimManaged),
+		})
+	}
+	plan := func(prior, planned tftypes.Value) resource.ModifyPlanResponse {
+		resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned}}
+		user.ModifyPlan(ctx, resource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: prior},
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: planned},
+		}, &resp)
+		return resp
+	}
+	absent := tftypes.NewValue(objectType, nil)
+
+	resp := plan(userValue("Jane", true), userValue("Jane Doe", true))
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "The user is managed by SCIM", resp.Diagnostics[0].Summary())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "only lets the identity provider change it")
+
+	resp = plan(userValue("Jane", true), absent)
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics[0].Detail(), "only lets the identity provider delete it")
+
+	assert.False(t, plan(userValue("Jane", true), userValue("Jane", true)).Diagnostics.HasError(), "no changes")
+	assert.False(t, plan(userValue("Jane", false), userValue("Jane Doe", false)).Diagnostics.HasError(), "not managed by SCIM")
+	assert.False(t, plan(absent, userValue("Jane", false)).Diagnostics.HasError(), "new users can't be managed by SCIM")
+}
+
+func TestScimHydrateFromApi(t *testing.T) {
+	assumeControl := true
+	model := scimResourceModel{
+		AssumeControlOfExistingUsers: types.BoolValue(false),
+		Token:                        types.StringValue("secret"),
+	}
+
+	model.hydrateFromApi(scimSettingsApiModel{Enabled: true, HasToken: true})
+	assert.True(t, model.Enabled.ValueBool())
+	assert.False(t, model.AssumeControlOfExistingUsers.ValueBool(), "kept when Unleash doesn't report it")
+	assert.Equal(t, "secret", model.Token.ValueString())
+
+	model.hydrateFromApi(scimSettingsApiModel{Enabled: true, AssumeControlOfExisting: &assumeControl})
+	assert.True(t, model.AssumeControlOfExistingUsers.ValueBool())
+	assert.True(t, model.Token.IsNull(), "the token is gone")
+}
+
+func TestScimRollsBackWhenTokenGenerationFails(t *testing.T) {
+	ctx := context.Background()
+	var posted []scimSettingsApiModel
+	client := testAdminApiClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case scimSettingsPath:
+			var settings scimSettingsApiModel
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&settings))
+			posted = append(posted, settings)
+			w.WriteHeader(http.StatusOK)
+		case scimSettingsPath + "/generate-new-token":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	scim := &scimResource{client: client}
+	schemaResp := resource.SchemaResponse{}
+	scim.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx)
+	scimValue := func(enabled bool, token any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"enabled":                          tftypes.NewValue(tftypes.Bool, enabled),
+			"assume_control_of_existing_users": tftypes.NewValue(tftypes.Bool, false),
+			"token":                            tftypes.NewValue(tftypes.String, token),
+			"rotate_token_trigger":             tftypes.NewValue(tftypes.String, nil),
+		})
+	}
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	scim.Create(ctx, resource.CreateRequest{
+		Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: scimValue(true, tftypes.UnknownValue)},
+	}, &createResp)
+	require.True(t, createResp.Diagnostics.HasError())
+	require.Len(t, posted, 2)
+	assert.True(t, posted[0].Enabled)
+	assert.False(t, posted[1].Enabled, "SCIM is disabled again")
+
+	posted = nil
+	updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: scimValue(false, "secret")}}
+	scim.Update(ctx, resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: scimValue(true, tftypes.UnknownValue)},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: scimValue(false, "secret")},
+	}, &updateResp)
+	require.True(t, updateResp.Diagnostics.HasError())
+	require.Len(t, posted, 2)
+	assert.True(t, posted[0].Enabled)
+	assert.False(t, posted[1].Enabled, "the previous settings are restored")
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &segmentDataSource{}
+	_ datasource.DataSourceWithConfigure = &segmentDataSource{}
+)
+
+func NewSegmentDataSource() datasource.DataSource {
+	return &segmentDataSource{}
+}
+
+type segmentDataSource struct {
+	client *unleash.APIClient
+}
+
+type segmentDataSourceModel struct {
+	Id          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	Project     types.String      `tfsdk:"project"`
+	Constraints []constraintModel `tfsdk:"constraints"`
+}
+
+func (d *segmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *segmentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment"
+}
+
+func (d *segmentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a segment by name.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the segment.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The id of the segment.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of what the segment is for.",
+				Computed:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The project the segment belongs to. Not set for global segments.",
+				Computed:    true,
+			},
+			"constraints": constraintsDataSourceAttribute("The constraints that make up this segment."),
+		},
+	}
+}
+
+func (d *segmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read segment data source")
+	var state segmentDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var segments segmentsApiModel
+	httpRes, err := adminApiRequest(ctx, d.client, http.MethodGet, "/api/admin/segments", nil, &segments)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	var segment *segmentApiModel
+	for i := range segments.Segments {
+		if segments.Segments[i].Name == state.Name.ValueString() {
+			segment = &segments.Segments[i]
+			break
+		}
+	}
+
+	if segment == nil {
+		resp.Diagnostics.AddError(
+			"Segment not found",
+			fmt.Sprintf("No segment matched the name %q.", state.Name.ValueString()),
+		)
+		return
+	}
+
+	state.Id = types.StringValue(strconv.FormatInt(segment.Id, 10))
+	state.Name = types.StringValue(segment.Name)
+
+	if segment.Description != nil && *segment.Description != "" {
+		state.Description = types.StringValue(*segment.Description)
+	} else {
+		state.Description = types.StringNull()
+	}
+
+	if segment.Project != nil && *segment.Project != "" {
+		state.Project = types.StringValue(*segment.Project)
+	} else {
+		state.Project = types.StringNull()
+	}
+
+	state.Constraints = flattenConstraints(ctx, []constraintModel{}, segment.Constraints, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading segment data source", map[string]any{"success": true})
+}
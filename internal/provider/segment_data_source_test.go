@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSegmentDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_segment" "internal_users" {
+						name        = "internal-users"
+						description = "Employees"
+						constraints = [
+							{
+								context_name = "userId"
+								operator     = "STR_ENDS_WITH"
+								values       = ["@example.com"]
+							}
+						]
+					}
+
+					data "unleash_segment" "internal_users" {
+						name = unleash_segment.internal_users.name
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.unleash_segment.internal_users", "id", "unleash_segment.internal_users", "id"),
+					resource.TestCheckResourceAttr("data.unleash_segment.internal_users", "description", "Employees"),
+					resource.TestCheckResourceAttr("data.unleash_segment.internal_users", "constraints.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_segment.internal_users", "constraints.0.operator", "STR_ENDS_WITH"),
+					resource.TestCheckResourceAttr("data.unleash_segment.internal_users", "constraints.0.values.0", "@example.com"),
+					resource.TestCheckNoResourceAttr("data.unleash_segment.internal_users", "project"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &segmentResource{}
+	_ resource.ResourceWithConfigure   = &segmentResource{}
+	_ resource.ResourceWithImportState = &segmentResource{}
+	_ resource.ResourceWithModifyPlan  = &segmentResource{}
+)
+
+func NewSegmentResource() resource.Resource {
+	return &segmentResource{}
+}
+
+type segmentResource struct {
+	client *unleash.APIClient
+}
+
+type segmentResourceModel struct {
+	Id           types.String      `tfsdk:"id"`
+	Name         types.String      `tfsdk:"name"`
+	Description  types.String      `tfsdk:"description"`
+	Project      types.String      `tfsdk:"project"`
+	Constraints  []constraintModel `tfsdk:"constraints"`
+	ForceDestroy types.Bool        `tfsdk:"force_destroy"`
+}
+
+type segmentApiModel struct {
+	Id          int64                `json:"id,omitempty"`
+	Name        string               `json:"name"`
+	Description *string              `json:"description"`
+	Project     *string              `json:"project"`
+	Constraints []constraintApiModel `json:"constraints"`
+}
+
+type segmentsApiModel struct {
+	Segments []segmentApiModel `json:"segments"`
+}
+
+type segmentStrategyApiModel struct {
+	Id           string `json:"id"`
+	FeatureName  string `json:"featureName"`
+	ProjectId    string `json:"projectId"`
+	Environment  string `json:"environment"`
+	StrategyName string `json:"strategyName"`
+}
+
+type segmentStrategiesApiModel struct {
+	Strategies              []segmentStrategyApiModel `json:"strategies"`
+	ChangeRequestStrategies []segmentStrategyApiModel `json:"changeRequestStrategies"`
+}
+
+type strategySegmentsApiModel struct {
+	ProjectId     string  `json:"projectId"`
+	EnvironmentId string  `json:"environmentId"`
+	StrategyId    string  `json:"strategyId"`
+	SegmentIds    []int64 `json:"segmentIds"`
+}
+
+func (r *segmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *segmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_segment"
+}
+
+func (r *segmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a segment, a reusable group of constraints that can be added to strategies.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the segment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the segment.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of what the segment is for.",
+				Optional:    true,
+			},
+			"project": schema.StringAttribute{
+				Description: "The project the segment belongs to. If not set, the segment is global and can be used in every project.",
+				Optional:    true,
+			},
+			"constraints": constraintsResourceAttribute("The constraints that make up this segment."),
+			"force_destroy": schema.BoolAttribute{
+				Description: "Remove the segment from the strategies still using it when the segment is destroyed. Defaults to false, in which case destroying a segment that is in use fails.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *segmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *segmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import segment resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_destroy"), false)...)
+
+	tflog.Debug(ctx, "Finished importing segment resource", map[string]any{"success": true})
+}
+
+func (r *segmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create segment resource")
+	var plan segmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.toApi(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var segment segmentApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/segments", request, &segment)
+	if !ValidateApiResponse(httpRes, 201, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(ctx, segment, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating segment resource", map[string]any{"success": true})
+}
+
+func (r *segmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read segment resource")
+	var state segmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var segment segmentApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, segmentPath(state.Id.ValueString()), nil, &segment)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Id.ValueString(), "Segment") {
+		return
+	}
+
+	state.hydrateFromApi(ctx, segment, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading segment resource", map[string]any{"success": true})
+}
+
+func (r *segmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update segment resource")
+	var plan segmentResourceModel
+	var state segmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = state.Id
+
+	request := plan.toApi(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, segmentPath(plan.Id.ValueString()), request, nil)
+	if !ValidateApiResponse(httpRes, 204, &resp.Diagnostics, err) {
+		return
+	}
+
+	// the update doesn't return the segment, so we need to re-read it
+	var segment segmentApiModel
+	httpRes, err = adminApiRequest(ctx, r.client, http.MethodGet, segmentPath(plan.Id.ValueString()), nil, &segment)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(ctx, segment, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating segment resource", map[string]any{"success": true})
+}
+
+func (r *segmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete segment resource")
+	var state segmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var usage segmentStrategiesApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, segmentPath(state.Id.ValueString())+"/strategies", nil, &usage)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	if len(usage.ChangeRequestStrategies) > 0 {
+		resp.Diagnostics.AddError(
+			"Segment is used in pending change requests",
+			fmt.Sprintf("Segment %s can't be deleted while change requests reference it:\n%s", state.Name.ValueString(), describeSegmentStrategies(usage.ChangeRequestStrategies)),
+		)
+		return
+	}
+
+	if len(usage.Strategies) > 0 {
+		if !state.ForceDestroy.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Segment is still in use",
+				fmt.Sprintf("Segment %s is used by the following strategies:\n%s\nRemove the segment from these strategies or set force_destroy = true to detach it before deleting.", state.Name.ValueString(), describeSegmentStrategies(usage.Strategies)),
+			)
+			return
+		}
+
+		if !r.detachFromStrategies(ctx, state.Id.ValueString(), usage.Strategies, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	httpRes, err = adminApiRequest(ctx, r.client, http.MethodDelete, segmentPath(state.Id.ValueString()), nil, nil)
+	if !ValidateApiResponse(httpRes, 204, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting segment resource", map[string]any{"success": true})
+}
+
+func (r *segmentResource) detachFromStrategies(ctx context.Context, segmentId string, strategies []segmentStrategyApiModel, diagnostics *diag.Diagnostics) bool {
+	id, err := strconv.ParseInt(segmentId, 10, 64)
+	if err != nil {
+		diagnostics.AddError("Invalid segment id", fmt.Sprintf("Segment id %q is not a number", segmentId))
+		return false
+	}
+
+	for _, strategy := range strategies {
+		tflog.Debug(ctx, fmt.Sprintf("Detaching segment %s from strategy %s on feature %s", segmentId, strategy.Id, strategy.FeatureName))
+
+		var segments segmentsApiModel
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, "/api/admin/segments/strategies/"+url.PathEscape(strategy.Id), nil, &segments)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return false
+		}
+
+		request := strategySegmentsApiModel{
+			ProjectId:     strategy.ProjectId,
+			EnvironmentId: strategy.Environment,
+			StrategyId:    strategy.Id,
+			SegmentIds:    remainingSegmentIds(segments.Segments, id),
+		}
+
+		httpRes, err = adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/segments/strategies", request, nil)
+		if !ValidateApiResponse(httpRes, 201, diagnostics, err) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *segmentResourceModel) toApi(ctx context.Context, diagnostics *diag.Diagnostics) segmentApiModel {
+	segment := segmentApiModel{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+		Project:     m.Project.ValueStringPointer(),
+		Constraints: expandConstraints(ctx, m.Constraints, diagnostics),
+	}
+
+	return segment
+}
+
+func (m *segmentResourceModel) hydrateFromApi(ctx context.Context, segment segmentApiModel, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(strconv.FormatInt(segment.Id, 10))
+	m.Name = types.StringValue(segment.Name)
+
+	if segment.Description != nil && *segment.Description != "" {
+		m.Description = types.StringValue(*segment.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+
+	if segment.Project != nil && *segment.Project != "" {
+		m.Project = types.StringValue(*segment.Project)
+	} else {
+		m.Project = types.StringNull()
+	}
+
+	m.Constraints = flattenConstraints(ctx, m.Constraints, segment.Constraints, diagnostics)
+
+	if m.ForceDestroy.IsNull() || m.ForceDestroy.IsUnknown() {
+		m.ForceDestroy = types.BoolValue(false)
+	}
+}
+
+func segmentPath(id string) string {
+	return "/api/admin/segments/" + url.PathEscape(id)
+}
+
+func remainingSegmentIds(segments []segmentApiModel, removed int64) []int64 {
+	ids := make([]int64, 0, len(segments))
+	for _, segment := range segments {
+		if segment.Id != removed {
+			ids = append(ids, segment.Id)
+		}
+	}
+	return ids
+}
+
+func describeSegmentStrategies(strategies []segmentStrategyApiModel) string {
+	lines := make([]string, 0, len(strategies))
+	for _, strategy := range strategies {
+		lines = append(lines, fmt.Sprintf("  - feature %s in project %s, environment %s (%s strategy %s)", strategy.FeatureName, strategy.ProjectId, strategy.Environment, strategy.StrategyName, strategy.Id))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSegmentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_segment" "beta_users" {
+						name = "beta-users"
+						constraints = [
+							{
+								context_name = "userId"
+								operator     = "IN"
+								values       = ["1", "2", "3"]
+							}
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_segment.beta_users", "id"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "name", "beta-users"),
+					resource.TestCheckNoResourceAttr("unleash_segment.beta_users", "description"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.#", "1"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.0.context_name", "userId"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.0.operator", "IN"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.0.values.#", "3"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.0.inverted", "false"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.0.case_insensitive", "false"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "force_destroy", "false"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_segment" "beta_users" {
+						name        = "beta-users"
+						description = "Users enrolled in the beta programme"
+						constraints = [
+							{
+								context_name = "userId"
+								operator     = "IN"
+								values       = ["1", "2"]
+								inverted     = true
+							},
+							{
+								context_name     = "appName"
+								operator         = "STR_STARTS_WITH"
+								values           = ["Beta"]
+								case_insensitive = true
+							},
+							{
+								context_name = "currentTime"
+								operator     = "DATE_AFTER"
+								value        = "2024-01-01T00:00:00.000Z"
+							}
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "description", "Users enrolled in the beta programme"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.#", "3"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.0.values.#", "2"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.0.inverted", "true"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.1.case_insensitive", "true"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.2.value", "2024-01-01T00:00:00.000Z"),
+					resource.TestCheckResourceAttr("unleash_segment.beta_users", "constraints.2.values.#", "0"),
+				),
+			},
+			{
+				ResourceName:            "unleash_segment.beta_users",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccSegmentResourceInProject(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_project" "segment_project" {
+						id   = "segment_project"
+						name = "Segment project"
+					}
+
+					resource "unleash_segment" "project_segment" {
+						name    = "project-only"
+						project = unleash_project.segment_project.id
+						constraints = [
+							{
+								context_name = "environment"
+								operator     = "IN"
+								values       = ["development"]
+							}
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_segment.project_segment", "project", "segment_project"),
+					resource.TestCheckResourceAttr("unleash_segment.project_segment", "constraints.#", "1"),
+				),
+			},
+		},
+	})
+}
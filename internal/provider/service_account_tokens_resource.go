@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &serviceAccountTokensResource{}
 	_ resource.ResourceWithConfigure   = &serviceAccountTokensResource{}
 	_ resource.ResourceWithImportState = &serviceAccountTokensResource{}
+	_ resource.ResourceWithModifyPlan  = &serviceAccountTokensResource{}
 )
 
 func NewServiceAccountTokensResource() resource.Resource {
@@ -98,6 +99,10 @@ func (r *serviceAccountTokensResource) Schema(_ context.Context, _ resource.Sche
 	}
 }
 
+func (r *serviceAccountTokensResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
 func (r *serviceAccountTokensResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Preparing to read service account tokens resource")
 	resource.ImportStatePassthroughID(ctx, path.Root("service_account"), req, resp)
@@ -0,0 +1,434 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &signalEndpointResource{}
+	_ resource.ResourceWithConfigure   = &signalEndpointResource{}
+	_ resource.ResourceWithImportState = &signalEndpointResource{}
+	_ resource.ResourceWithModifyPlan  = &signalEndpointResource{}
+)
+
+var signalEndpointNamePattern = regexp.MustCompile(`^[a-zA-Z0-9._~-]+$`)
+
+var signalEndpointTokenAttributeTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"name":  types.StringType,
+	"token": types.StringType,
+}
+
+func NewSignalEndpointResource() resource.Resource {
+	return &signalEndpointResource{}
+}
+
+type signalEndpointResource struct {
+	client *unleash.APIClient
+}
+
+type signalEndpointResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Enabled     types.Bool     `tfsdk:"enabled"`
+	Url         types.String   `tfsdk:"url"`
+	TokenNames  []types.String `tfsdk:"token_names"`
+	Tokens      types.List     `tfsdk:"tokens"`
+}
+
+type signalEndpointTokenModel struct {
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Token types.String `tfsdk:"token"`
+}
+
+type signalEndpointApiModel struct {
+	Id          int64                         `json:"id,omitempty"`
+	Name        string                        `json:"name"`
+	Description *string                       `json:"description"`
+	Enabled     bool                          `json:"enabled"`
+	Tokens      []signalEndpointTokenApiModel `json:"tokens,omitempty"`
+}
+
+type signalEndpointTokenApiModel struct {
+	Id    int64  `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Token string `json:"token,omitempty"`
+}
+
+func (r *signalEndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *signalEndpointResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signal_endpoint"
+}
+
+func (r *signalEndpointResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a signal endpoint, which external systems such as alerting call to send signals that `unleash_action_set` resources react to. Signal endpoints are an enterprise feature.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The id of the signal endpoint.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the signal endpoint. It is part of the endpoint URL, so it must be URL-safe.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(signalEndpointNamePattern, "must only contain letters, digits, '.', '_', '~' and '-'"),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of what the signal endpoint is for.",
+				Optional:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the signal endpoint accepts signals. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"url": schema.StringAttribute{
+				Description: "The URL to post signals to, authenticated with one of the tokens as a bearer token.",
+				Computed:    true,
+			},
+			"token_names": schema.SetAttribute{
+				Description: "The names of the tokens to create for the signal endpoint. Removing a name deletes its token.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"tokens": schema.ListNestedAttribute{
+				Description: "The tokens of the signal endpoint. Unleash only reveals a token when it is created, so the secret of a token created outside of Terraform is null.",
+				Computed:    true,
+				Sensitive:   true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The id of the token.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the token.",
+							Computed:    true,
+						},
+						"token": schema.StringAttribute{
+							Description: "The secret of the token.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan works out the URL from the name, and keeps the known tokens unless token_names changes.
+func (r *signalEndpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan signalEndpointResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.IsUnknown() {
+		if endpointUrl, err := signalEndpointUrl(ctx, r.client, plan.Name.ValueString()); err == nil {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("url"), endpointUrl)...)
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var state signalEndpointResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if sameStringSet(plan.TokenNames, state.TokenNames) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tokens"), state.Tokens)...)
+	}
+}
+
+func (r *signalEndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import signal endpoint resource")
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tflog.Debug(ctx, "Finished importing signal endpoint resource", map[string]any{"success": true})
+}
+
+func (r *signalEndpointResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create signal endpoint resource")
+	var plan signalEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var endpoint signalEndpointApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/signal-endpoints", plan.toApi(), &endpoint)
+	if !ValidateApiResponse(httpRes, 201, &resp.Diagnostics, err) {
+		return
+	}
+	plan.Id = types.StringValue(strconv.FormatInt(endpoint.Id, 10))
+	plan.Tokens = types.ListNull(types.ObjectType{AttrTypes: signalEndpointTokenAttributeTypes})
+
+	// the endpoint exists now, so it's saved even if creating its tokens fails
+	r.syncTokens(ctx, &plan, &resp.Diagnostics)
+	r.hydrate(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating signal endpoint resource", map[string]any{"success": true})
+}
+
+func (r *signalEndpointResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read signal endpoint resource")
+	var state signalEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var endpoint signalEndpointApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, signalEndpointPath(state.Id.ValueString()), nil, &endpoint)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Id.ValueString(), "Signal endpoint") {
+		return
+	}
+
+	state.hydrateFromApi(ctx, endpoint, &resp.Diagnostics)
+	if endpointUrl, err := signalEndpointUrl(ctx, r.client, endpoint.Name); err == nil {
+		state.Url = types.StringValue(endpointUrl)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading signal endpoint resource", map[string]any{"success": true})
+}
+
+func (r *signalEndpointResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update signal endpoint resource")
+	var plan signalEndpointResourceModel
+	var state signalEndpointResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Id = state.Id
+	plan.Tokens = state.Tokens
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, signalEndpointPath(plan.Id.ValueString()), plan.toApi(), nil)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	r.syncTokens(ctx, &plan, &resp.Diagnostics)
+	r.hydrate(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished updating signal endpoint resource", map[string]any{"success": true})
+}
+
+func (r *signalEndpointResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete signal endpoint resource")
+	var state signalEndpointResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, signalEndpointPath(state.Id.ValueString()), nil, nil)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting signal endpoint resource", map[string]any{"success": true})
+}
+
+// syncTokens creates the tokens named in token_names that don't exist yet and deletes the ones no longer named. The
+// secrets of created tokens are added to the tokens in the model, since Unleash never returns them again.
+func (r *signalEndpointResource) syncTokens(ctx context.Context, model *signalEndpointResourceModel, diagnostics *diag.Diagnostics) {
+	current := model.tokens(ctx, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	var existing struct {
+		Tokens []signalEndpointTokenApiModel `json:"signalEndpointTokens"`
+	}
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, signalEndpointPath(model.Id.ValueString())+"/tokens", nil, &existing)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return
+	}
+
+	wanted := stringSliceValues(model.TokenNames)
+	for _, token := range existing.Tokens {
+		if slices.Contains(wanted, token.Name) {
+			continue
+		}
+		tokenPath := signalEndpointPath(model.Id.ValueString()) + "/tokens/" + strconv.FormatInt(token.Id, 10)
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, tokenPath, nil, nil)
+		if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+			return
+		}
+	}
+
+	for _, name := range wanted {
+		if slices.ContainsFunc(existing.Tokens, func(token signalEndpointTokenApiModel) bool { return token.Name == name }) {
+			continue
+		}
+		var token signalEndpointTokenApiModel
+		httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, signalEndpointPath(model.Id.ValueString())+"/tokens", signalEndpointTokenApiModel{Name: name}, &token)
+		if !ValidateApiResponse(httpRes, 201, diagnostics, err) {
+			return
+		}
+		current = append(current, signalEndpointTokenModel{
+			Id:    types.StringValue(strconv.FormatInt(token.Id, 10)),
+			Name:  types.StringValue(token.Name),
+			Token: types.StringValue(token.Token),
+		})
+	}
+
+	model.setTokens(ctx, current, diagnostics)
+}
+
+// hydrate re-reads the signal endpoint after a change.
+func (r *signalEndpointResource) hydrate(ctx context.Context, model *signalEndpointResourceModel, diagnostics *diag.Diagnostics) {
+	var endpoint signalEndpointApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, signalEndpointPath(model.Id.ValueString()), nil, &endpoint)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return
+	}
+
+	model.hydrateFromApi(ctx, endpoint, diagnostics)
+	endpointUrl, err := signalEndpointUrl(ctx, r.client, endpoint.Name)
+	if err != nil {
+		diagnostics.AddError("Unable to determine the signal endpoint URL", err.Error())
+		return
+	}
+	model.Url = types.StringValue(endpointUrl)
+}
+
+func (m *signalEndpointResourceModel) toApi() signalEndpointApiModel {
+	return signalEndpointApiModel{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+		Enabled:     m.Enabled.ValueBool(),
+	}
+}
+
+func (m *signalEndpointResourceModel) tokens(ctx context.Context, diagnostics *diag.Diagnostics) []signalEndpointTokenModel {
+	tokens := []signalEndpointTokenModel{}
+	if m.Tokens.IsNull() || m.Tokens.IsUnknown() {
+		return tokens
+	}
+	diagnostics.Append(m.Tokens.ElementsAs(ctx, &tokens, false)...)
+	return tokens
+}
+
+func (m *signalEndpointResourceModel) setTokens(ctx context.Context, tokens []signalEndpointTokenModel, diagnostics *diag.Diagnostics) {
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: signalEndpointTokenAttributeTypes}, tokens)
+	diagnostics.Append(diags...)
+	m.Tokens = list
+}
+
+// hydrateFromApi reads the endpoint and its tokens, keeping the secrets of the tokens already in the model.
+func (m *signalEndpointResourceModel) hydrateFromApi(ctx context.Context, endpoint signalEndpointApiModel, diagnostics *diag.Diagnostics) {
+	m.Id = types.StringValue(strconv.FormatInt(endpoint.Id, 10))
+	m.Name = types.StringValue(endpoint.Name)
+	m.Description = optionalStringValue(endpoint.Description)
+	m.Enabled = types.BoolValue(endpoint.Enabled)
+
+	secrets := map[string]types.String{}
+	for _, token := range m.tokens(ctx, diagnostics) {
+		secrets[token.Id.ValueString()] = token.Token
+	}
+
+	tokens := make([]signalEndpointTokenModel, 0, len(endpoint.Tokens))
+	names := make([]types.String, 0, len(endpoint.Tokens))
+	for _, token := range endpoint.Tokens {
+		id := strconv.FormatInt(token.Id, 10)
+		secret, ok := secrets[id]
+		if !ok {
+			secret = types.StringNull()
+		}
+		tokens = append(tokens, signalEndpointTokenModel{
+			Id:    types.StringValue(id),
+			Name:  types.StringValue(token.Name),
+			Token: secret,
+		})
+		if !slices.Contains(names, types.StringValue(token.Name)) {
+			names = append(names, types.StringValue(token.Name))
+		}
+	}
+	m.setTokens(ctx, tokens, diagnostics)
+
+	if len(names) > 0 || m.TokenNames != nil {
+		m.TokenNames = names
+	}
+}
+
+func signalEndpointPath(id string) string {
+	return "/api/admin/signal-endpoints/" + url.PathEscape(id)
+}
+
+// signalEndpointUrl is where signals for the endpoint are posted to, relative to the configured Unleash URL.
+func signalEndpointUrl(ctx context.Context, client *unleash.APIClient, name string) (string, error) {
+	baseUrl, err := client.GetConfig().ServerURLWithContext(ctx, "")
+	if err != nil {
+		return "", fmt.Errorf("unable to read the Unleash URL: %w", err)
+	}
+	return strings.TrimSuffix(baseUrl, "/") + "/api/signal-endpoint/" + url.PathEscape(name), nil
+}
+
+func sameStringSet(a []types.String, b []types.String) bool {
+	left := stringSliceValues(a)
+	right := stringSliceValues(b)
+	slices.Sort(left)
+	slices.Sort(right)
+	return slices.Equal(left, right)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSignalEndpointResource(t *testing.T) {
+	skipUnlessEnterpriseCompatiblePlan(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_signal_endpoint" "alerts" {
+						name        = "tf-alerts"
+						token_names = ["alerting"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("unleash_signal_endpoint.alerts", "id"),
+					resource.TestCheckResourceAttr("unleash_signal_endpoint.alerts", "enabled", "true"),
+					resource.TestMatchResourceAttr("unleash_signal_endpoint.alerts", "url", regexp.MustCompile(`/api/signal-endpoint/tf-alerts$`)),
+					resource.TestCheckResourceAttr("unleash_signal_endpoint.alerts", "tokens.#", "1"),
+					resource.TestCheckResourceAttr("unleash_signal_endpoint.alerts", "tokens.0.name", "alerting"),
+					resource.TestCheckResourceAttrSet("unleash_signal_endpoint.alerts", "tokens.0.token"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_signal_endpoint" "alerts" {
+						name        = "tf-alerts"
+						description = "Alerts from our monitoring"
+						enabled     = false
+						token_names = ["alerting", "paging"]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_signal_endpoint.alerts", "description", "Alerts from our monitoring"),
+					resource.TestCheckResourceAttr("unleash_signal_endpoint.alerts", "enabled", "false"),
+					resource.TestCheckResourceAttr("unleash_signal_endpoint.alerts", "tokens.#", "2"),
+					resource.TestCheckResourceAttrSet("unleash_signal_endpoint.alerts", "tokens.0.token"),
+					resource.TestCheckResourceAttrSet("unleash_signal_endpoint.alerts", "tokens.1.token"),
+				),
+			},
+			{
+				ResourceName:            "unleash_signal_endpoint.alerts",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"tokens"},
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignalEndpointHydrateKeepsTokenSecrets(t *testing.T) {
+	ctx := context.Background()
+	var diagnostics diag.Diagnostics
+
+	model := signalEndpointResourceModel{TokenNames: stringValues([]string{"alerting"})}
+	model.setTokens(ctx, []signalEndpointTokenModel{{
+		Id:    types.StringValue("1"),
+		Name:  types.StringValue("alerting"),
+		Token: types.StringValue("secret"),
+	}}, &diagnostics)
+
+	model.hydrateFromApi(ctx, signalEndpointApiModel{
+		Id:      7,
+		Name:    "alerts",
+		Enabled: true,
+		Tokens: []signalEndpointTokenApiModel{
+			{Id: 1, Name: "alerting"},
+			{Id: 2, Name: "created-in-the-ui"},
+		},
+	}, &diagnostics)
+	require.False(t, diagnostics.HasError(), diagnostics)
+
+	tokens := model.tokens(ctx, &diagnostics)
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "secret", tokens[0].Token.ValueString())
+	assert.True(t, tokens[1].Token.IsNull(), "the secret is only known when the token is created")
+	assert.Equal(t, []string{"alerting", "created-in-the-ui"}, stringSliceValues(model.TokenNames))
+	assert.Equal(t, "7", model.Id.ValueString())
+}
+
+func TestSignalEndpointHydrateWithoutTokens(t *testing.T) {
+	ctx := context.Background()
+	var diagnostics diag.Diagnostics
+
+	model := signalEndpointResourceModel{}
+	model.hydrateFromApi(ctx, signalEndpointApiModel{Id: 7, Name: "alerts"}, &diagnostics)
+	require.False(t, diagnostics.HasError(), diagnostics)
+
+	assert.Nil(t, model.TokenNames, "token_names stays unset when it isn't configured")
+	assert.Empty(t, model.tokens(ctx, &diagnostics))
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &strategiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &strategiesDataSource{}
+)
+
+func NewStrategiesDataSource() datasource.DataSource {
+	return &strategiesDataSource{}
+}
+
+type strategiesDataSource struct {
+	client *unleash.APIClient
+}
+
+type strategiesDataSourceModel struct {
+	IncludeDeprecated types.Bool                    `tfsdk:"include_deprecated"`
+	Strategies        []strategyDataSourceItemModel `tfsdk:"strategies"`
+}
+
+type strategyDataSourceItemModel struct {
+	Name        types.String             `tfsdk:"name"`
+	DisplayName types.String             `tfsdk:"display_name"`
+	Description types.String             `tfsdk:"description"`
+	Editable    types.Bool               `tfsdk:"editable"`
+	Deprecated  types.Bool               `tfsdk:"deprecated"`
+	Parameters  []strategyParameterModel `tfsdk:"parameters"`
+}
+
+func (d *strategiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *strategiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_strategies"
+}
+
+func (d *strategiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the activation strategies available in Unleash, both built-in and custom.",
+		Attributes: map[string]schema.Attribute{
+			"include_deprecated": schema.BoolAttribute{
+				Description: "Whether deprecated strategies should be included. Defaults to false.",
+				Optional:    true,
+			},
+			"strategies": schema.ListNestedAttribute{
+				Description: "The list of strategies.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the strategy.",
+							Computed:    true,
+						},
+						"display_name": schema.StringAttribute{
+							Description: "A human friendly name for the strategy.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of the strategy.",
+							Computed:    true,
+						},
+						"editable": schema.BoolAttribute{
+							Description: "Whether the strategy can be edited. Strategies bundled with Unleash can't be edited.",
+							Computed:    true,
+						},
+						"deprecated": schema.BoolAttribute{
+							Description: "Whether the strategy is deprecated.",
+							Computed:    true,
+						},
+						"parameters": schema.ListNestedAttribute{
+							Description: "The parameters of the strategy.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "The name of the parameter.",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "The type of the parameter.",
+										Computed:    true,
+									},
+									"description": schema.StringAttribute{
+										Description: "A description of what the parameter does.",
+										Computed:    true,
+									},
+									"required": schema.BoolAttribute{
+										Description: "Whether the parameter must be set when the strategy is used.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *strategiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read strategies data source")
+	var state strategiesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var strategies strategiesApiModel
+	httpRes, err := adminApiRequest(ctx, d.client, http.MethodGet, "/api/admin/strategies", nil, &strategies)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.Strategies = make([]strategyDataSourceItemModel, 0, len(strategies.Strategies))
+	for _, strategy := range strategies.Strategies {
+		if strategy.Deprecated && !state.IncludeDeprecated.ValueBool() {
+			continue
+		}
+
+		var definition strategyResourceModel
+		definition.hydrateFromApi(strategy)
+
+		item := strategyDataSourceItemModel{
+			Name:        definition.Name,
+			DisplayName: types.StringPointerValue(strategy.DisplayName),
+			Description: definition.Description,
+			Editable:    types.BoolValue(strategy.Editable),
+			Deprecated:  definition.Deprecated,
+			Parameters:  definition.Parameters,
+		}
+		if item.Parameters == nil {
+			item.Parameters = []strategyParameterModel{}
+		}
+
+		state.Strategies = append(state.Strategies, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading strategies data source", map[string]any{"success": true})
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStrategiesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_strategy" "listed" {
+						name = "listedStrategy"
+					}
+
+					data "unleash_strategies" "all" {
+						depends_on = [unleash_strategy.listed]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.unleash_strategies.all", "strategies.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.unleash_strategies.all", "strategies.*", map[string]string{
+						"name":     "flexibleRollout",
+						"editable": "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.unleash_strategies.all", "strategies.*", map[string]string{
+						"name":       "listedStrategy",
+						"deprecated": "false",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Constraints are shared by strategies, segments and anything else that evaluates an Unleash context.
+var constraintOperators = []string{
+	"NOT_IN", "IN",
+	"STR_ENDS_WITH", "STR_STARTS_WITH", "STR_CONTAINS",
+	"NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE",
+	"DATE_AFTER", "DATE_BEFORE",
+	"SEMVER_EQ", "SEMVER_GT", "SEMVER_LT",
+	"REGEX",
+}
+
+type constraintModel struct {
+	ContextName     types.String `tfsdk:"context_name"`
+	Operator        types.String `tfsdk:"operator"`
+	Values          types.List   `tfsdk:"values"`
+	Value           types.String `tfsdk:"value"`
+	Inverted        types.Bool   `tfsdk:"inverted"`
+	CaseInsensitive types.Bool   `tfsdk:"case_insensitive"`
+}
+
+type constraintApiModel struct {
+	ContextName     string   `json:"contextName"`
+	Operator        string   `json:"operator"`
+	Values          []string `json:"values"`
+	Value           *string  `json:"value,omitempty"`
+	Inverted        bool     `json:"inverted"`
+	CaseInsensitive bool     `json:"caseInsensitive"`
+}
+
+func constraintsResourceAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Optional:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"context_name": schema.StringAttribute{
+					Description: "The name of the context field this constraint applies to.",
+					Required:    true,
+				},
+				"operator": schema.StringAttribute{
+					Description: "The operator used to evaluate the constraint, for example `IN`, `STR_CONTAINS`, `NUM_GT` or `SEMVER_EQ`.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(constraintOperators...),
+					},
+				},
+				"values": schema.ListAttribute{
+					Description: "The context values evaluated by multi value operators such as `IN` and `STR_CONTAINS`.",
+					Optional:    true,
+					Computed:    true,
+					ElementType: types.StringType,
+					Default:     listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+				},
+				"value": schema.StringAttribute{
+					Description: "The context value evaluated by single value operators such as `NUM_EQ`, `DATE_AFTER` and `SEMVER_GT`.",
+					Optional:    true,
+				},
+				"inverted": schema.BoolAttribute{
+					Description: "Whether the result of the constraint should be negated. Defaults to false.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+				"case_insensitive": schema.BoolAttribute{
+					Description: "Whether string operators ignore case. Defaults to false.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+func constraintsDataSourceAttribute(description string) datasourceschema.ListNestedAttribute {
+	return datasourceschema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: datasourceschema.NestedAttributeObject{
+			Attributes: map[string]datasourceschema.Attribute{
+				"context_name": datasourceschema.StringAttribute{
+					Description: "The name of the context field this constraint applies to.",
+					Computed:    true,
+				},
+				"operator": datasourceschema.StringAttribute{
+					Description: "The operator used to evaluate the constraint.",
+					Computed:    true,
+				},
+				"values": datasourceschema.ListAttribute{
+					Description: "The context values evaluated by multi value operators.",
+					Computed:    true,
+					ElementType: types.StringType,
+				},
+				"value": datasourceschema.StringAttribute{
+					Description: "The context value evaluated by single value operators.",
+					Computed:    true,
+				},
+				"inverted": datasourceschema.BoolAttribute{
+					Description: "Whether the result of the constraint is negated.",
+					Computed:    true,
+				},
+				"case_insensitive": datasourceschema.BoolAttribute{
+					Description: "Whether string operators ignore case.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func expandConstraints(ctx context.Context, models []constraintModel, diagnostics *diag.Diagnostics) []constraintApiModel {
+	constraints := make([]constraintApiModel, 0, len(models))
+
+	for _, model := range models {
+		constraint := constraintApiModel{
+			ContextName:     model.ContextName.ValueString(),
+			Operator:        model.Operator.ValueString(),
+			Values:          []string{},
+			Inverted:        model.Inverted.ValueBool(),
+			CaseInsensitive: model.CaseInsensitive.ValueBool(),
+		}
+
+		if !model.Values.IsNull() && !model.Values.IsUnknown() {
+			diagnostics.Append(model.Values.ElementsAs(ctx, &constraint.Values, false)...)
+			if diagnostics.HasError() {
+				return nil
+			}
+		}
+
+		if !model.Value.IsNull() && !model.Value.IsUnknown() {
+			constraint.Value = model.Value.ValueStringPointer()
+		}
+
+		constraints = append(constraints, constraint)
+	}
+
+	return constraints
+}
+
+// flattenConstraints keeps an empty list in state when the configuration had one, and null otherwise.
+func flattenConstraints(ctx context.Context, current []constraintModel, constraints []constraintApiModel, diagnostics *diag.Diagnostics) []constraintModel {
+	if len(constraints) == 0 {
+		if current == nil {
+			return nil
+		}
+		return []constraintModel{}
+	}
+
+	models := make([]constraintModel, 0, len(constraints))
+
+	for _, constraint := range constraints {
+		values := constraint.Values
+		if values == nil {
+			values = []string{}
+		}
+
+		valuesList, diags := types.ListValueFrom(ctx, types.StringType, values)
+		diagnostics.Append(diags...)
+
+		model := constraintModel{
+			ContextName:     types.StringValue(constraint.ContextName),
+			Operator:        types.StringValue(constraint.Operator),
+			Values:          valuesList,
+			Value:           types.StringNull(),
+			Inverted:        types.BoolValue(constraint.Inverted),
+			CaseInsensitive: types.BoolValue(constraint.CaseInsensitive),
+		}
+
+		if constraint.Value != nil && *constraint.Value != "" {
+			model.Value = types.StringValue(*constraint.Value)
+		}
+
+		models = append(models, model)
+	}
+
+	return models
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpandConstraints(t *testing.T) {
+	var diagnostics diag.Diagnostics
+
+	constraints := expandConstraints(context.Background(), []constraintModel{
+		{
+			ContextName:     types.StringValue("userId"),
+			Operator:        types.StringValue("IN"),
+			Values:          types.ListValueMust(types.StringType, []attr.Value{types.StringValue("1"), types.StringValue("2")}),
+			Value:           types.StringNull(),
+			Inverted:        types.BoolValue(true),
+			CaseInsensitive: types.BoolValue(false),
+		},
+		{
+			ContextName:     types.StringValue("currentTime"),
+			Operator:        types.StringValue("DATE_AFTER"),
+			Values:          types.ListNull(types.StringType),
+			Value:           types.StringValue("2024-01-01T00:00:00.000Z"),
+			Inverted:        types.BoolValue(false),
+			CaseInsensitive: types.BoolValue(false),
+		},
+	}, &diagnostics)
+
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if len(constraints) != 2 {
+		t.Fatalf("expected 2 constraints, got %d", len(constraints))
+	}
+	if got := constraints[0].Values; len(got) != 2 || got[0] != "1" || got[1] != "2" {
+		t.Fatalf("unexpected values %v", got)
+	}
+	if !constraints[0].Inverted {
+		t.Fatal("expected first constraint to be inverted")
+	}
+	if constraints[0].Value != nil {
+		t.Fatal("expected first constraint to have no single value")
+	}
+	if constraints[1].Values == nil || len(constraints[1].Values) != 0 {
+		t.Fatalf("expected null values to be sent as an empty list, got %v", constraints[1].Values)
+	}
+	if constraints[1].Value == nil || *constraints[1].Value != "2024-01-01T00:00:00.000Z" {
+		t.Fatal("expected single value to be sent")
+	}
+}
+
+func TestFlattenConstraintsPreservesEmptyAndNullLists(t *testing.T) {
+	var diagnostics diag.Diagnostics
+
+	if got := flattenConstraints(context.Background(), nil, nil, &diagnostics); got != nil {
+		t.Fatalf("expected null constraints to stay null, got %v", got)
+	}
+	if got := flattenConstraints(context.Background(), []constraintModel{}, nil, &diagnostics); got == nil || len(got) != 0 {
+		t.Fatalf("expected empty constraints to stay empty, got %v", got)
+	}
+}
+
+func TestFlattenConstraintsNormalizesEmptyValue(t *testing.T) {
+	var diagnostics diag.Diagnostics
+	empty := ""
+
+	models := flattenConstraints(context.Background(), nil, []constraintApiModel{
+		{ContextName: "userId", Operator: "IN", Value: &empty},
+	}, &diagnostics)
+
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if !models[0].Value.IsNull() {
+		t.Fatal("expected empty value to map to null")
+	}
+	if models[0].Values.IsNull() || len(models[0].Values.Elements()) != 0 {
+		t.Fatal("expected missing values to map to an empty list")
+	}
+}
@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &strategyResource{}
+	_ resource.ResourceWithConfigure   = &strategyResource{}
+	_ resource.ResourceWithImportState = &strategyResource{}
+	_ resource.ResourceWithModifyPlan  = &strategyResource{}
+)
+
+var strategyParameterTypes = []string{"string", "percentage", "list", "number", "boolean"}
+
+func NewStrategyResource() resource.Resource {
+	return &strategyResource{}
+}
+
+type strategyResource struct {
+	client *unleash.APIClient
+}
+
+type strategyResourceModel struct {
+	Name        types.String             `tfsdk:"name"`
+	Description types.String             `tfsdk:"description"`
+	Parameters  []strategyParameterModel `tfsdk:"parameters"`
+	Deprecated  types.Bool               `tfsdk:"deprecated"`
+}
+
+type strategyParameterModel struct {
+	Name        types.String `tfsdk:"name"`
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Required    types.Bool   `tfsdk:"required"`
+}
+
+type strategyApiModel struct {
+	Name        string                      `json:"name"`
+	DisplayName *string                     `json:"displayName,omitempty"`
+	Description *string                     `json:"description,omitempty"`
+	Editable    bool                        `json:"editable,omitempty"`
+	Deprecated  bool                        `json:"deprecated,omitempty"`
+	Parameters  []strategyParameterApiModel `json:"parameters"`
+}
+
+type strategyParameterApiModel struct {
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Description *string `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+}
+
+type strategiesApiModel struct {
+	Strategies []strategyApiModel `json:"strategies"`
+}
+
+func (r *strategyResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *strategyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_strategy"
+}
+
+func (r *strategyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom activation strategy definition. Unleash keeps strategies around for historical reasons, so destroying this resource deprecates the strategy instead of deleting it, and creating a strategy with the name of a deprecated one reactivates it.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the strategy. This is the name SDKs use to look up the strategy implementation.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the strategy.",
+				Optional:    true,
+			},
+			"parameters": schema.ListNestedAttribute{
+				Description: "The parameters passed to the strategy implementation in the SDKs.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the parameter.",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the parameter. Valid values are `string`, `percentage`, `list`, `number` and `boolean`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(strategyParameterTypes...),
+							},
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of what the parameter does.",
+							Optional:    true,
+						},
+						"required": schema.BoolAttribute{
+							Description: "Whether the parameter must be set when the strategy is used. Defaults to false.",
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"deprecated": schema.BoolAttribute{
+				Description: "Whether the strategy is deprecated. Deprecated strategies can't be added to new feature flags. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *strategyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *strategyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import strategy resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+
+	tflog.Debug(ctx, "Finished importing strategy resource", map[string]any{"success": true})
+}
+
+func (r *strategyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create strategy resource")
+	var plan strategyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var existing strategyApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, strategyPath(plan.Name.ValueString()), nil, &existing)
+	if isNotFoundResponse(httpRes) {
+		httpRes, err = adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/strategies", plan.toApi(), nil)
+		if !ValidateApiResponse(httpRes, 201, &resp.Diagnostics, err) {
+			return
+		}
+	} else {
+		if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+			return
+		}
+
+		if !existing.Deprecated {
+			resp.Diagnostics.AddError(
+				"Strategy already exists",
+				fmt.Sprintf("A strategy named %s already exists. Import it with `terraform import` to manage it with Terraform.", plan.Name.ValueString()),
+			)
+			return
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Strategy %s exists but is deprecated, reactivating it", plan.Name.ValueString()))
+		if !r.update(ctx, plan, true, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	if plan.Deprecated.ValueBool() && !r.setDeprecated(ctx, plan.Name.ValueString(), true, &resp.Diagnostics) {
+		return
+	}
+
+	if !r.hydrateState(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished creating strategy resource", map[string]any{"success": true})
+}
+
+func (r *strategyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read strategy resource")
+	var state strategyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var strategy strategyApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, strategyPath(state.Name.ValueString()), nil, &strategy)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Name.ValueString(), "Strategy") {
+		return
+	}
+
+	state.hydrateFromApi(strategy)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading strategy resource", map[string]any{"success": true})
+}
+
+func (r *strategyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update strategy resource")
+	var plan strategyResourceModel
+	var state strategyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !r.update(ctx, plan, state.Deprecated.ValueBool() && !plan.Deprecated.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
+	if plan.Deprecated.ValueBool() && !state.Deprecated.ValueBool() && !r.setDeprecated(ctx, plan.Name.ValueString(), true, &resp.Diagnostics) {
+		return
+	}
+
+	if !r.hydrateState(ctx, &plan, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating strategy resource", map[string]any{"success": true})
+}
+
+func (r *strategyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete strategy resource, this will deprecate the strategy")
+	var state strategyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Deprecated.ValueBool() && !r.setDeprecated(ctx, state.Name.ValueString(), true, &resp.Diagnostics) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting strategy resource", map[string]any{"success": true})
+}
+
+// update sends the description and parameters, reactivating the strategy first when asked to.
+func (r *strategyResource) update(ctx context.Context, plan strategyResourceModel, reactivate bool, diagnostics *diag.Diagnostics) bool {
+	if reactivate && !r.setDeprecated(ctx, plan.Name.ValueString(), false, diagnostics) {
+		return false
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, strategyPath(plan.Name.ValueString()), plan.toApi(), nil)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+func (r *strategyResource) setDeprecated(ctx context.Context, name string, deprecated bool, diagnostics *diag.Diagnostics) bool {
+	action := "reactivate"
+	if deprecated {
+		action = "deprecate"
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, strategyPath(name)+"/"+action, nil, nil)
+	return ValidateApiResponse(httpRes, 200, diagnostics, err)
+}
+
+func (r *strategyResource) hydrateState(ctx context.Context, state *strategyResourceModel, diagnostics *diag.Diagnostics) bool {
+	var strategy strategyApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, strategyPath(state.Name.ValueString()), nil, &strategy)
+	if !ValidateApiResponse(httpRes, 200, diagnostics, err) {
+		return false
+	}
+
+	state.hydrateFromApi(strategy)
+	return true
+}
+
+func (m *strategyResourceModel) toApi() strategyApiModel {
+	strategy := strategyApiModel{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueStringPointer(),
+		Parameters:  make([]strategyParameterApiModel, 0, len(m.Parameters)),
+	}
+
+	for _, parameter := range m.Parameters {
+		strategy.Parameters = append(strategy.Parameters, strategyParameterApiModel{
+			Name:        parameter.Name.ValueString(),
+			Type:        parameter.Type.ValueString(),
+			Description: parameter.Description.ValueStringPointer(),
+			Required:    parameter.Required.ValueBool(),
+		})
+	}
+
+	return strategy
+}
+
+func (m *strategyResourceModel) hydrateFromApi(strategy strategyApiModel) {
+	m.Name = types.StringValue(strategy.Name)
+	m.Deprecated = types.BoolValue(strategy.Deprecated)
+
+	if strategy.Description != nil && *strategy.Description != "" {
+		m.Description = types.StringValue(*strategy.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+
+	if len(strategy.Parameters) == 0 {
+		if m.Parameters != nil {
+			m.Parameters = []strategyParameterModel{}
+		}
+		return
+	}
+
+	m.Parameters = make([]strategyParameterModel, 0, len(strategy.Parameters))
+	for _, parameter := range strategy.Parameters {
+		model := strategyParameterModel{
+			Name:        types.StringValue(parameter.Name),
+			Type:        types.StringValue(parameter.Type),
+			Description: types.StringNull(),
+			Required:    types.BoolValue(parameter.Required),
+		}
+		if parameter.Description != nil && *parameter.Description != "" {
+			model.Description = types.StringValue(*parameter.Description)
+		}
+		m.Parameters = append(m.Parameters, model)
+	}
+}
+
+func strategyPath(name string) string {
+	return "/api/admin/strategies/" + url.PathEscape(name)
+}
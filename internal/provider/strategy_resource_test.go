@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStrategyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_strategy" "tenant" {
+						name = "tenantRollout"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "name", "tenantRollout"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "deprecated", "false"),
+					resource.TestCheckNoResourceAttr("unleash_strategy.tenant", "description"),
+					resource.TestCheckNoResourceAttr("unleash_strategy.tenant", "parameters"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_strategy" "tenant" {
+						name        = "tenantRollout"
+						description = "Enables a feature for a list of tenants"
+						parameters = [
+							{
+								name        = "tenants"
+								type        = "list"
+								description = "Tenant ids"
+								required    = true
+							},
+							{
+								name = "rollout"
+								type = "percentage"
+							}
+						]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "description", "Enables a feature for a list of tenants"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "parameters.#", "2"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "parameters.0.name", "tenants"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "parameters.0.type", "list"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "parameters.0.required", "true"),
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "parameters.1.required", "false"),
+					resource.TestCheckNoResourceAttr("unleash_strategy.tenant", "parameters.1.description"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_strategy" "tenant" {
+						name        = "tenantRollout"
+						description = "Enables a feature for a list of tenants"
+						deprecated  = true
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy.tenant", "deprecated", "true"),
+				),
+			},
+			{
+				ResourceName:                         "unleash_strategy.tenant",
+				ImportStateId:                        "tenantRollout",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccStrategyResourceReactivatesDeprecatedStrategy(t *testing.T) {
+	config := `
+		resource "unleash_strategy" "region" {
+			name = "regionRollout"
+			parameters = [
+				{
+					name = "regions"
+					type = "list"
+				}
+			]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			{
+				Config:  config,
+				Destroy: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_strategy.region", "deprecated", "false"),
+					resource.TestCheckResourceAttr("unleash_strategy.region", "parameters.#", "1"),
+				),
+			},
+		},
+	})
+}
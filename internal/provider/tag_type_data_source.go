@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &tagTypeDataSource{}
+	_ datasource.DataSourceWithConfigure = &tagTypeDataSource{}
+)
+
+func NewTagTypeDataSource() datasource.DataSource {
+	return &tagTypeDataSource{}
+}
+
+type tagTypeDataSource struct {
+	client *unleash.APIClient
+}
+
+type tagTypeDataSourceModel struct {
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Icon        types.String   `tfsdk:"icon"`
+	Tags        []types.String `tfsdk:"tags"`
+}
+
+func (d *tagTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *tagTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_type"
+}
+
+func (d *tagTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a tag type by name, together with the values of the tags of that type.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the tag type.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the tag type.",
+				Computed:    true,
+			},
+			"icon": schema.StringAttribute{
+				Description: "The icon shown next to tags of this type in the Unleash UI.",
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "The values of the tags of this type.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (d *tagTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read tag type data source")
+	var state tagTypeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagType tagTypeResponseApiModel
+	httpRes, err := adminApiRequest(ctx, d.client, http.MethodGet, tagTypePath(state.Name.ValueString()), nil, &tagType)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	var tags tagsApiModel
+	httpRes, err = adminApiRequest(ctx, d.client, http.MethodGet, tagsPath(state.Name.ValueString()), nil, &tags)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	var definition tagTypeResourceModel
+	definition.hydrateFromApi(tagType.unwrap())
+
+	state.Name = definition.Name
+	state.Description = definition.Description
+	state.Icon = definition.Icon
+	state.Tags = make([]types.String, 0, len(tags.Tags))
+	for _, tag := range tags.Tags {
+		state.Tags = append(state.Tags, types.StringValue(tag.Value))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading tag type data source", map[string]any{"success": true})
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagTypeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-tag-type-feature")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_tag_type" "jira" {
+						name        = "tf-jira"
+						description = "The Jira ticket tracking the feature"
+					}
+
+					resource "unleash_feature_tags" "tagged" {
+						feature = "tf-tag-type-feature"
+						tags = [
+							{
+								type  = unleash_tag_type.jira.name
+								value = "CHK-42"
+							}
+						]
+					}
+
+					data "unleash_tag_type" "jira" {
+						name       = unleash_tag_type.jira.name
+						depends_on = [unleash_feature_tags.tagged]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_tag_type.jira", "description", "The Jira ticket tracking the feature"),
+					resource.TestCheckNoResourceAttr("data.unleash_tag_type.jira", "icon"),
+					resource.TestCheckResourceAttr("data.unleash_tag_type.jira", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_tag_type.jira", "tags.0", "CHK-42"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &tagTypeResource{}
+	_ resource.ResourceWithConfigure   = &tagTypeResource{}
+	_ resource.ResourceWithImportState = &tagTypeResource{}
+	_ resource.ResourceWithModifyPlan  = &tagTypeResource{}
+)
+
+func NewTagTypeResource() resource.Resource {
+	return &tagTypeResource{}
+}
+
+type tagTypeResource struct {
+	client *unleash.APIClient
+}
+
+type tagTypeResourceModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Icon        types.String `tfsdk:"icon"`
+}
+
+type tagTypeApiModel struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Icon        *string `json:"icon,omitempty"`
+}
+
+// tagTypeResponseApiModel accepts a tag type either on its own or wrapped in a "tagType" property.
+type tagTypeResponseApiModel struct {
+	tagTypeApiModel
+	TagType *tagTypeApiModel `json:"tagType"`
+}
+
+func (r tagTypeResponseApiModel) unwrap() tagTypeApiModel {
+	if r.TagType != nil {
+		return *r.TagType
+	}
+	return r.tagTypeApiModel
+}
+
+type tagTypesApiModel struct {
+	TagTypes []tagTypeApiModel `json:"tagTypes"`
+}
+
+func (r *tagTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	r.client = client
+}
+
+func (r *tagTypeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag_type"
+}
+
+func (r *tagTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a tag type. Tag types group the tags that can be attached to feature flags, for example `team` or `jira`.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "The name of the tag type.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(), // the name is the id of the tag type
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the tag type.",
+				Optional:    true,
+			},
+			"icon": schema.StringAttribute{
+				Description: "The icon shown next to tags of this type in the Unleash UI.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *tagTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+}
+
+func (r *tagTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Debug(ctx, "Preparing to import tag type resource")
+
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+
+	tflog.Debug(ctx, "Finished importing tag type resource", map[string]any{"success": true})
+}
+
+func (r *tagTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Preparing to create tag type resource")
+	var plan tagTypeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := plan.toApi()
+	request.Name = plan.Name.ValueString()
+
+	var tagType tagTypeResponseApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPost, "/api/admin/tag-types", request, &tagType)
+	if !ValidateApiResponse(httpRes, 201, &resp.Diagnostics, err) {
+		return
+	}
+
+	plan.hydrateFromApi(tagType.unwrap())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	tflog.Debug(ctx, "Finished creating tag type resource", map[string]any{"success": true})
+}
+
+func (r *tagTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read tag type resource")
+	var state tagTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tagType tagTypeResponseApiModel
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodGet, tagTypePath(state.Name.ValueString()), nil, &tagType)
+	if !ValidateReadApiResponse(ctx, httpRes, err, resp, state.Name.ValueString(), "Tag type") {
+		return
+	}
+
+	state.hydrateFromApi(tagType.unwrap())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+	tflog.Debug(ctx, "Finished reading tag type resource", map[string]any{"success": true})
+}
+
+func (r *tagTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Preparing to update tag type resource")
+	var plan tagTypeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodPut, tagTypePath(plan.Name.ValueString()), plan.toApi(), nil)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	tflog.Debug(ctx, "Finished updating tag type resource", map[string]any{"success": true})
+}
+
+func (r *tagTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Preparing to delete tag type resource")
+	var state tagTypeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := adminApiRequest(ctx, r.client, http.MethodDelete, tagTypePath(state.Name.ValueString()), nil, nil)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+	tflog.Debug(ctx, "Finished deleting tag type resource", map[string]any{"success": true})
+}
+
+func tagTypePath(name string) string {
+	return "/api/admin/tag-types/" + url.PathEscape(name)
+}
+
+func (m *tagTypeResourceModel) toApi() tagTypeApiModel {
+	// the update endpoint leaves fields that are left out untouched, so send empty strings to clear them
+	description := m.Description.ValueString()
+	icon := m.Icon.ValueString()
+	return tagTypeApiModel{
+		Description: &description,
+		Icon:        &icon,
+	}
+}
+
+func (m *tagTypeResourceModel) hydrateFromApi(tagType tagTypeApiModel) {
+	m.Name = types.StringValue(tagType.Name)
+
+	if tagType.Description != nil && *tagType.Description != "" {
+		m.Description = types.StringValue(*tagType.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+
+	if tagType.Icon != nil && *tagType.Icon != "" {
+		m.Icon = types.StringValue(*tagType.Icon)
+	} else {
+		m.Icon = types.StringNull()
+	}
+}
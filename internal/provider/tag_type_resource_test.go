@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagTypeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_tag_type" "team" {
+						name = "tf-team"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_tag_type.team", "name", "tf-team"),
+					resource.TestCheckNoResourceAttr("unleash_tag_type.team", "description"),
+					resource.TestCheckNoResourceAttr("unleash_tag_type.team", "icon"),
+				),
+			},
+			{
+				Config: `
+					resource "unleash_tag_type" "team" {
+						name        = "tf-team"
+						description = "The team that owns the feature"
+						icon        = "group"
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("unleash_tag_type.team", "description", "The team that owns the feature"),
+					resource.TestCheckResourceAttr("unleash_tag_type.team", "icon", "group"),
+				),
+			},
+			{
+				ResourceName:                         "unleash_tag_type.team",
+				ImportState:                          true,
+				ImportStateId:                        "tf-team",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
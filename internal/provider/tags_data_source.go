@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &tagsDataSource{}
+	_ datasource.DataSourceWithConfigure = &tagsDataSource{}
+)
+
+func NewTagsDataSource() datasource.DataSource {
+	return &tagsDataSource{}
+}
+
+type tagsDataSource struct {
+	client *unleash.APIClient
+}
+
+type tagsDataSourceModel struct {
+	Type types.String `tfsdk:"type"`
+	Tags []tagModel   `tfsdk:"tags"`
+}
+
+func (d *tagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *tagsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tags"
+}
+
+func (d *tagsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the tags in use in Unleash, optionally limited to a single tag type.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "Only return tags of this type. Returns tags of every type if not set.",
+				Optional:    true,
+			},
+			"tags": schema.ListNestedAttribute{
+				Description: "The list of tags.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "The tag type.",
+							Computed:    true,
+						},
+						"value": schema.StringAttribute{
+							Description: "The value of the tag.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *tagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read tags data source")
+	var state tagsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tags tagsApiModel
+	httpRes, err := adminApiRequest(ctx, d.client, http.MethodGet, tagsPath(state.Type.ValueString()), nil, &tags)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	state.Tags = flattenTags(tags.Tags)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading tags data source", map[string]any{"success": true})
+}
+
+func tagsPath(tagType string) string {
+	if tagType == "" {
+		return "/api/admin/tags"
+	}
+	return "/api/admin/tags/" + url.PathEscape(tagType)
+}
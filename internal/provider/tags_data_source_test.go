@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTagsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-tags-feature")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "unleash_tag_type" "slack" {
+						name = "tf-slack"
+					}
+
+					resource "unleash_feature_tags" "tagged" {
+						feature = "tf-tags-feature"
+						tags = [
+							{
+								type  = unleash_tag_type.slack.name
+								value = "#checkout"
+							},
+							{
+								type  = "simple"
+								value = "listed"
+							}
+						]
+					}
+
+					data "unleash_tags" "slack" {
+						type       = unleash_tag_type.slack.name
+						depends_on = [unleash_feature_tags.tagged]
+					}
+
+					data "unleash_tags" "all" {
+						depends_on = [unleash_feature_tags.tagged]
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.unleash_tags.slack", "tags.#", "1"),
+					resource.TestCheckResourceAttr("data.unleash_tags.slack", "tags.0.type", "tf-slack"),
+					resource.TestCheckResourceAttr("data.unleash_tags.slack", "tags.0.value", "#checkout"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.unleash_tags.all", "tags.*", map[string]string{
+						"type":  "simple",
+						"value": "listed",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	unleash "github.com/Unleash/unleash-server-api-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &unusedFeaturesDataSource{}
+	_ datasource.DataSourceWithConfigure = &unusedFeaturesDataSource{}
+)
+
+func NewUnusedFeaturesDataSource() datasource.DataSource {
+	return &unusedFeaturesDataSource{}
+}
+
+type unusedFeaturesDataSource struct {
+	client *unleash.APIClient
+}
+
+type unusedFeaturesDataSourceModel struct {
+	Project  types.String         `tfsdk:"project"`
+	Days     types.Int64          `tfsdk:"days"`
+	Features []unusedFeatureModel `tfsdk:"features"`
+}
+
+type unusedFeatureModel struct {
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Stale      types.Bool   `tfsdk:"stale"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	LastSeenAt types.String `tfsdk:"last_seen_at"`
+}
+
+func (d *unusedFeaturesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*unleash.APIClient)
+	if !ok {
+		tflog.Error(ctx, "Unable to prepare client")
+		return
+	}
+	d.client = client
+}
+
+func (d *unusedFeaturesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unused_features"
+}
+
+func (d *unusedFeaturesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the feature flags of a project that no SDK has reported for a number of days, to find flags that are safe to clean up.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "The project to look for unused features in.",
+				Required:    true,
+			},
+			"days": schema.Int64Attribute{
+				Description: "How many days a feature must have gone unreported. Features that were never reported count once they are this old.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"features": schema.ListNestedAttribute{
+				Description: "The unused features.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the feature.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "The type of the feature.",
+							Computed:    true,
+						},
+						"stale": schema.BoolAttribute{
+							Description: "Whether the feature is marked as stale.",
+							Computed:    true,
+						},
+						"created_at": schema.StringAttribute{
+							Description: "When the feature was created.",
+							Computed:    true,
+						},
+						"last_seen_at": schema.StringAttribute{
+							Description: "When an SDK last reported the feature, or null if it never did.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *unusedFeaturesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Preparing to read unused features data source")
+	var state unusedFeaturesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var features projectFeaturesApiModel
+	httpRes, err := cachedAdminGet(ctx, d.client, projectFeaturesPath(state.Project.ValueString()), &features)
+	if !ValidateApiResponse(httpRes, 200, &resp.Diagnostics, err) {
+		return
+	}
+
+	since := time.Now().AddDate(0, 0, -int(state.Days.ValueInt64()))
+	state.Features = []unusedFeatureModel{}
+	for _, feature := range unusedFeatures(features.Features, since, &resp.Diagnostics) {
+		state.Features = append(state.Features, unusedFeatureModel{
+			Name:       types.StringValue(feature.Name),
+			Type:       types.StringValue(feature.Type),
+			Stale:      types.BoolValue(feature.Stale),
+			CreatedAt:  types.StringValue(feature.CreatedAt),
+			LastSeenAt: types.StringPointerValue(feature.LastSeenAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	tflog.Debug(ctx, "Finished reading unused features data source", map[string]any{"success": true})
+}
+
+// unusedFeatures returns the features last reported before since, or never reported and created before since.
+// Features with a date that can't be read are left out with a warning.
+func unusedFeatures(features []projectFeatureApiModel, since time.Time, diagnostics *diag.Diagnostics) []projectFeatureApiModel {
+	unused := []projectFeatureApiModel{}
+
+	for _, feature := range features {
+		reference := feature.CreatedAt
+		if feature.LastSeenAt != nil {
+			reference = *feature.LastSeenAt
+		}
+
+		seen, err := time.Parse(time.RFC3339, reference)
+		if err != nil {
+			diagnostics.AddWarning(
+				"Unreadable feature date",
+				fmt.Sprintf("Feature %s was last seen or created at %q, which isn't an RFC 3339 date, so it isn't checked.", feature.Name, reference),
+			)
+			continue
+		}
+		if seen.Before(since) {
+			unused = append(unused, feature)
+		}
+	}
+
+	return unused
+}
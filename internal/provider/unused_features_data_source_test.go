@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUnusedFeaturesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccCreateFeature(t, "default", "tf-unused")
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// a feature created moments ago hasn't been unused for a day yet
+				Config: `
+					data "unleash_unused_features" "stale" {
+						project = "default"
+						days    = 1
+					}
+
+					output "includes_new_feature" {
+						value = contains(data.unleash_unused_features.stale.features[*].name, "tf-unused")
+					}
+				`,
+				Check: resource.TestCheckOutput("includes_new_feature", "false"),
+			},
+		},
+	})
+}
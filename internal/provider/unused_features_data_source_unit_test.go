@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnusedFeatures(t *testing.T) {
+	now := time.Now().UTC()
+	daysAgo := func(days int) string {
+		return now.AddDate(0, 0, -days).Format(time.RFC3339)
+	}
+	seenRecently := daysAgo(2)
+	seenLongAgo := daysAgo(60)
+
+	features := []projectFeatureApiModel{
+		{Name: "active", CreatedAt: daysAgo(90), LastSeenAt: &seenRecently},
+		{Name: "forgotten", CreatedAt: daysAgo(90), LastSeenAt: &seenLongAgo},
+		{Name: "never-used", CreatedAt: daysAgo(45)},
+		{Name: "brand-new", CreatedAt: daysAgo(1)},
+		{Name: "unparsable", CreatedAt: "yesterday"},
+	}
+
+	var diags diag.Diagnostics
+	var names []string
+	for _, feature := range unusedFeatures(features, now.AddDate(0, 0, -30), &diags) {
+		names = append(names, feature.Name)
+	}
+	assert.Equal(t, []string{"forgotten", "never-used"}, names)
+
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.SeverityWarning, diags[0].Severity())
+	assert.Contains(t, diags[0].Detail(), "unparsable")
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
 )
 
 // NewUserResource is a helper function to simplify the provider implementation.
@@ -32,13 +35,14 @@ type userResource struct {
 }
 
 type userResourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	Username  types.String `tfsdk:"username"`
-	Email     types.String `tfsdk:"email"`
-	Name      types.String `tfsdk:"name"`
-	Password  types.String `tfsdk:"password"`
-	RootRole  types.Int64  `tfsdk:"root_role"`
-	SendEmail types.Bool   `tfsdk:"send_email"`
+	Id          types.String `tfsdk:"id"`
+	Username    types.String `tfsdk:"username"`
+	Email       types.String `tfsdk:"email"`
+	Name        types.String `tfsdk:"name"`
+	Password    types.String `tfsdk:"password"`
+	RootRole    types.Int64  `tfsdk:"root_role"`
+	SendEmail   types.Bool   `tfsdk:"send_email"`
+	ScimManaged types.Bool   `tfsdk:"scim_managed"`
 }
 
 // Configure adds the provider configured client to the data source.
@@ -98,10 +102,22 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"scim_managed": schema.BoolAttribute{
+				Description: fmt.Sprintf(scimManagedDescription, "user"),
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	warnAboutMaintenanceMode(ctx, r.client, req, resp)
+	refuseScimManagedChanges(ctx, "user", req, resp)
+}
+
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	tflog.Debug(ctx, "Preparing to import user resource")
 
@@ -160,6 +176,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 	} else {
 		plan.Name = types.StringNull()
 	}
+	plan.ScimManaged = types.BoolValue(user.GetScimId() != "")
 	// TODO note the output state is not the same as input state
 	// here in output state we're saying what happened (i.e. Id is present)
 	// but in the input state we don't know if the email was sent or not
@@ -218,6 +235,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	state.RootRole = types.Int64Value(int64(*user.RootRole))
+	state.ScimManaged = types.BoolValue(user.GetScimId() != "")
 	tflog.Debug(ctx, "Finished populating model", map[string]any{"success": true})
 
 	// Set state
@@ -285,6 +303,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		state.Name = types.StringNull()
 	}
 	state.RootRole = types.Int64Value(int64(*user.RootRole.Int32))
+	state.ScimManaged = types.BoolValue(user.GetScimId() != "")
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
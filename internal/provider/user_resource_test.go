@@ -86,6 +86,7 @@ func TestAccUserResource(t *testing.T) {
 					resource.TestCheckResourceAttr("unleash_user.the_newbie", "name", "Test User"),
 					resource.TestCheckResourceAttr("unleash_user.the_newbie", "email", "test@getunleash.io"),
 					resource.TestCheckResourceAttr("unleash_user.the_newbie", "root_role", "2"),
+					resource.TestCheckResourceAttr("unleash_user.the_newbie", "scim_managed", "false"),
 					// TODO test the remote object matches https://developer.hashicorp.com/terraform/plugin/testing/testing-patterns#basic-test-to-verify-attributes
 				),
 			},